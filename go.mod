@@ -0,0 +1,9 @@
+module github.com/kehindesalaam/go-paystack
+
+go 1.23
+
+require (
+	github.com/google/go-cmp v0.6.0
+	github.com/google/go-querystring v1.1.0
+	github.com/mitchellh/mapstructure v1.4.0
+)
@@ -8,8 +8,8 @@ import (
 type BalanceService service
 
 type Balance struct {
-	Currency *string `json:"currency, omitempty"`
-	Balance  *int    `json:"balance, omitempty"`
+	Currency *Currency `json:"currency, omitempty"`
+	Balance  *Money    `json:"balance, omitempty"`
 }
 
 //Check returns an array of balances
@@ -22,16 +22,10 @@ func (s *BalanceService) Check(ctx context.Context) ([]*Balance, *Response, erro
 	if err != nil {
 		return nil, nil, err
 	}
-	lr := new(StandardListResponse)
+	lr := new(Envelope[[]*Balance])
 	resp, err := s.client.Do(ctx, req, lr)
 	if err != nil {
 		return nil, resp, err
 	}
-	var ba []*Balance
-	for _, x := range lr.Data {
-		b := new(Balance)
-		mapDecoder(x, b)
-		ba = append(ba, b)
-	}
-	return ba, resp, nil
+	return lr.Data, resp, nil
 }
@@ -30,7 +30,7 @@ func TestBalanceService_Check(t *testing.T) {
 	if err != nil {
 		t.Errorf("Balance.Check returned error: %v", err)
 	}
-	want := []*Balance{{Currency: String("NGN"), Balance: Int(1700000)}}
+	want := []*Balance{{Currency: NGN.Ptr(), Balance: NewMoney(1700000, NGN)}}
 	if !reflect.DeepEqual(balance, want) {
 		t.Errorf("Balance.Check returned %+v, want %+v", balance, want)
 	}
@@ -3,14 +3,17 @@ package paystack
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 )
 
 type BulkChargeService service
 
 type BulkBatchRequest struct {
-	Authorization *string `json:"authorization, omitempty"`
-	Amount        *int    `json:"amount, omitempty"`
+	Authorization *string     `json:"authorization, omitempty"`
+	Amount        *Money      `json:"amount, omitempty"`
+	Reference     *string     `json:"reference,omitempty"`
+	Metadata      MetadataMap `json:"metadata,omitempty"`
 }
 
 type BulkBatch struct {
@@ -32,9 +35,11 @@ type BulkCharge struct {
 	Authorization Authorization `json:"authorization, omitempty"`
 	Transaction   Transaction   `json:"transaction, omitempty"`
 	Domain        *string       `json:"domain, omitempty"`
-	Amount        *int          `json:"amount, omitempty"`
-	Currency      *string       `json:"currency, omitempty"`
+	Amount        *Money        `json:"amount, omitempty"`
+	Currency      *Currency     `json:"currency, omitempty"`
 	Status        *string       `json:"status, omitempty"`
+	Reference     *string       `json:"reference,omitempty"`
+	Metadata      MetadataMap   `json:"metadata,omitempty"`
 	Id            *int          `json:"id, omitempty"`
 	CreatedAt     *time.Time    `json:"created_at, omitempty"`
 	UpdatedAt     *time.Time    `json:"updated_at, omitempty"`
@@ -50,14 +55,12 @@ func (s *BulkChargeService) Initiate(ctx context.Context, request []*BulkBatchRe
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[BulkBatch])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	bb := new(BulkBatch)
-	mapDecoder(r.Data, bb)
-	return bb, resp, nil
+	return &r.Data, resp, nil
 }
 
 //ListBatches
@@ -73,22 +76,28 @@ func (s *BulkChargeService) ListBatches(ctx context.Context, opt *ListOptions) (
 		return nil, nil, err
 	}
 
-	lr := new(StandardListResponse)
+	lr := new(Envelope[[]*BulkBatch])
 	resp, err := s.client.Do(ctx, req, lr)
-
 	if err != nil {
 		return nil, resp, err
 	}
-	var bba []*BulkBatch
-	for _, x := range lr.Data {
-		bb := new(BulkBatch)
-		mapDecoder(x, bb)
-		bba = append(bba, bb)
-	}
-	return bba, resp, nil
+	return lr.Data, resp, nil
 
 }
 
+// ListAllBatches iterates over all bulk charge batches, calling ListBatches for each page
+func (s *BulkChargeService) ListAllBatches(ctx context.Context, opt *ListOptions, iopt *IterOptions) iter.Seq2[*BulkBatch, error] {
+	var o ListOptions
+	if opt != nil {
+		o = *opt
+	}
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]*BulkBatch, *Response, error) {
+		o := o
+		o.Page = page
+		return s.ListBatches(ctx, &o)
+	}, iopt)
+}
+
 //FetchBatch
 //
 // Paystack API reference:
@@ -99,41 +108,49 @@ func (s *BulkChargeService) FetchBatch(ctx context.Context, id string) (*BulkBat
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[BulkBatch])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(BulkBatch)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
 
-//FetchBatchCharges
+// FetchBatchCharges returns the charges of a batch, optionally filtered by
+// status
 //
 // Paystack API reference:
-// https://developers.paystack.co/reference#fetch-transaction
-func (s *BulkChargeService) FetchBatchCharges(ctx context.Context, id string, opt *BullkChargeOptions) ([]*BulkCharge, *Response, error) {
-	u := fmt.Sprintf("bulkcharge/" + id + "charges")
-	//Response is erroneous if opt.Page or opt.PerPage = 0
-
+// https://developers.paystack.co/reference#fetch-charges-in-a-batch
+func (s *BulkChargeService) FetchBatchCharges(ctx context.Context, id string, opt *BulkChargeOptions) ([]*BulkCharge, *Response, error) {
+	u := fmt.Sprintf("bulkcharge/%s/charges", id)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
-	lr := new(StandardListResponse)
+	lr := new(Envelope[[]*BulkCharge])
 	resp, err := s.client.Do(ctx, req, lr)
-
 	if err != nil {
 		return nil, resp, err
 	}
-	var ta []*BulkCharge
-	c := new(BulkCharge)
-	for _, x := range lr.Data {
-		mapDecoder(x, c)
-		ta = append(ta, c)
+	return lr.Data, resp, nil
+}
+
+// FetchAllBatchCharges iterates over all charges of a batch, calling
+// FetchBatchCharges for each page
+func (s *BulkChargeService) FetchAllBatchCharges(ctx context.Context, id string, opt *BulkChargeOptions, iopt *IterOptions) iter.Seq2[*BulkCharge, error] {
+	var o BulkChargeOptions
+	if opt != nil {
+		o = *opt
 	}
-	return ta, resp, nil
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]*BulkCharge, *Response, error) {
+		o := o
+		o.Page = page
+		return s.FetchBatchCharges(ctx, id, &o)
+	}, iopt)
 }
 
 //PauseBatch
@@ -141,19 +158,17 @@ func (s *BulkChargeService) FetchBatchCharges(ctx context.Context, id string, op
 // Paystack API reference:
 // https://developers.paystack.co/reference#pause-bulk-charge-batch
 func (s *BulkChargeService) PauseBatch(ctx context.Context, batch_code string) (*Message, *Response, error) {
-	u := fmt.Sprintf("bulkcharge/pause" + batch_code)
+	u := fmt.Sprintf("bulkcharge/pause/%s", batch_code)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Message])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(Message)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
 
 //ResumeBatch
@@ -161,17 +176,15 @@ func (s *BulkChargeService) PauseBatch(ctx context.Context, batch_code string) (
 // Paystack API reference:
 // https://developers.paystack.co/reference#resume-bulk-charge-batch
 func (s *BulkChargeService) ResumeBatch(ctx context.Context, batch_code string) (*Message, *Response, error) {
-	u := fmt.Sprintf("bulkcharge/resume" + batch_code)
+	u := fmt.Sprintf("bulkcharge/resume/%s", batch_code)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Message])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(Message)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
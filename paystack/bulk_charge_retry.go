@@ -0,0 +1,175 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Metadata keys set by RetryFailed on the charges it retries.
+const (
+	MetadataRetryOf      = "retry_of"
+	MetadataRetryOfBatch = "retry_of_batch"
+)
+
+// A RetryRule tells why a failed bulk charge should not be charged again, or
+// returns "" to let RetryFailed retry it.
+type RetryRule func(ch *BulkCharge) string
+
+// SkipNonReusable skips the charges on authorizations that cannot be
+// charged again.
+func SkipNonReusable(ch *BulkCharge) string {
+	if r := ch.Authorization.Reusable; r != nil && !*r {
+		return "authorization is not reusable"
+	}
+	return ""
+}
+
+// SkipGatewayResponses returns a RetryRule that skips the charges declined
+// with one of responses, such as "Do Not Honor" or "Lost Card", compared
+// without regard to case.
+func SkipGatewayResponses(responses ...string) RetryRule {
+	return func(ch *BulkCharge) string {
+		got := strings.ToLower(ch.Transaction.GetGatewayResponse())
+		for _, r := range responses {
+			if strings.Contains(got, strings.ToLower(r)) {
+				return "declined with " + ch.Transaction.GetGatewayResponse()
+			}
+		}
+		return ""
+	}
+}
+
+// DefaultRetryRules are the rules used by RetryFailed when none are given:
+// they skip non-reusable authorizations and declines that retrying will not
+// overturn.
+var DefaultRetryRules = []RetryRule{
+	SkipNonReusable,
+	SkipGatewayResponses("Do Not Honor", "Do Not Honour", "Lost Card", "Stolen Card", "Pick Up Card", "Restricted Card"),
+}
+
+// RetryFailedOptions configures RetryFailed.
+type RetryFailedOptions struct {
+	// Rules select the failed charges to retry, DefaultRetryRules by
+	// default. A charge is retried when no rule skips it.
+	Rules []RetryRule
+
+	// Reference returns the reference of the retry of ch. By default it is
+	// derived from the reference of ch and the code of its batch, so that
+	// Paystack rejects as duplicates the charges of a second retry of the
+	// same batch, as when the outcome of the first one is unknown.
+	Reference func(ch *BulkCharge) string
+}
+
+// SkippedCharge is a failed bulk charge that RetryFailed did not retry.
+type SkippedCharge struct {
+	Charge *BulkCharge
+	Reason string
+}
+
+// RetriedCharge is a failed bulk charge and the request retrying it.
+type RetriedCharge struct {
+	Charge  *BulkCharge
+	Request *BulkBatchRequest
+}
+
+// BatchRetry links a bulk charge batch to the batch retrying its failed
+// charges.
+type BatchRetry struct {
+	// From is the code of the original batch, and Batch the new batch, nil
+	// when no charge was retried.
+	From  string
+	Batch *BulkBatch
+
+	Retried []RetriedCharge
+	Skipped []SkippedCharge
+}
+
+// RetryFailed charges again, as a new batch, the failed charges of a
+// completed batch that are not skipped by the rules of opt. opt may be nil.
+//
+// Each retry keeps the metadata of the failed charge, such as an invoice
+// number, and adds the reference of the failed charge and the code of its
+// batch under MetadataRetryOf and MetadataRetryOfBatch, so that it can be
+// traced back.
+func (s *BulkChargeService) RetryFailed(ctx context.Context, code string, opt *RetryFailedOptions) (*BatchRetry, *Response, error) {
+	var o RetryFailedOptions
+	if opt != nil {
+		o = *opt
+	}
+	if o.Rules == nil {
+		o.Rules = DefaultRetryRules
+	}
+	if o.Reference == nil {
+		o.Reference = func(ch *BulkCharge) string { return retryReference(ch, code) }
+	}
+
+	b, resp, err := s.FetchBatch(ctx, code)
+	if err != nil {
+		return nil, resp, err
+	}
+	if b.GetPendingCharges() > 0 {
+		return nil, resp, fmt.Errorf("paystack: batch %s still has %d pending charges", code, b.GetPendingCharges())
+	}
+
+	retry := &BatchRetry{From: b.GetBatchCode()}
+	var requests []*BulkBatchRequest
+	failed := &BulkChargeOptions{ListOptions: ListOptions{PerPage: 100}, Status: "failed"}
+	for ch, err := range s.FetchAllBatchCharges(ctx, code, failed, nil) {
+		if err != nil {
+			return nil, resp, err
+		}
+		if reason := skipReason(o.Rules, ch); reason != "" {
+			retry.Skipped = append(retry.Skipped, SkippedCharge{Charge: ch, Reason: reason})
+			continue
+		}
+		metadata := MetadataMap{}
+		for k, v := range ch.Metadata {
+			metadata[k] = v
+		}
+		metadata[MetadataRetryOf] = ch.GetReference()
+		metadata[MetadataRetryOfBatch] = retry.From
+		req := &BulkBatchRequest{
+			Authorization: ch.Authorization.AuthorizationCode,
+			Amount:        ch.Amount,
+			Reference:     String(o.Reference(ch)),
+			Metadata:      metadata,
+		}
+		requests = append(requests, req)
+		retry.Retried = append(retry.Retried, RetriedCharge{Charge: ch, Request: req})
+	}
+	if len(requests) == 0 {
+		return retry, resp, nil
+	}
+
+	retry.Batch, resp, err = s.Initiate(ctx, requests)
+	if err != nil {
+		return nil, resp, err
+	}
+	return retry, resp, nil
+}
+
+// retryReference returns the default reference of the retry of ch, a charge
+// of the batch with the given code. Underscores are left out of the batch
+// code, since Paystack does not accept them in references.
+func retryReference(ch *BulkCharge, batch string) string {
+	ref := ch.GetReference()
+	if ref == "" {
+		ref = ch.Transaction.GetReference()
+	}
+	if ref == "" {
+		ref = strconv.Itoa(ch.GetId())
+	}
+	return ref + "-retry-" + strings.ReplaceAll(batch, "_", "")
+}
+
+// skipReason returns the reason given by the first rule skipping ch.
+func skipReason(rules []RetryRule, ch *BulkCharge) string {
+	for _, rule := range rules {
+		if reason := rule(ch); reason != "" {
+			return reason
+		}
+	}
+	return ""
+}
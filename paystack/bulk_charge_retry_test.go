@@ -0,0 +1,140 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBulkChargeService_RetryFailed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/bulkcharge/BCH_180tl7oq7cayggh", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": true, "message": "Bulk charge retrieved", "data": {"batch_code": "BCH_180tl7oq7cayggh", "status": "complete", "total_charges": 5, "pending_charges": 0}}`)
+	})
+	mux.HandleFunc("/bulkcharge/BCH_180tl7oq7cayggh/charges", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"status": "failed", "page": "1", "perPage": "100"})
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Bulk charge items retrieved",
+		  "data": [
+			{
+			  "id": 1, "status": "failed", "amount": 2500, "currency": "NGN", "reference": "inv-1",
+			  "metadata": {"invoice": "INV-1"},
+			  "authorization": {"authorization_code": "AUTH_n95vpedf", "reusable": true},
+			  "transaction": {"gateway_response": "Insufficient Funds"}
+			},
+			{
+			  "id": 2, "status": "failed", "amount": 1500, "currency": "NGN", "reference": "inv-2",
+			  "authorization": {"authorization_code": "AUTH_ljdt4e4j", "reusable": false}
+			},
+			{
+			  "id": 3, "status": "failed", "amount": 1500, "currency": "NGN", "reference": "inv-3",
+			  "authorization": {"authorization_code": "AUTH_9l0mnz7e", "reusable": true},
+			  "transaction": {"gateway_response": "DO NOT HONOR"}
+			}
+		  ],
+		  "meta": {"total": 3, "perPage": 100, "page": 1, "pageCount": 1}
+		}`)
+	})
+	mux.HandleFunc("/bulkcharge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body []map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		want := []map[string]interface{}{{
+			"authorization": "AUTH_n95vpedf",
+			"amount":        float64(2500),
+			"reference":     "inv-1-r1",
+			"metadata": map[string]interface{}{
+				"invoice":            "INV-1",
+				MetadataRetryOf:      "inv-1",
+				MetadataRetryOfBatch: "BCH_180tl7oq7cayggh",
+			},
+		}}
+		if !cmp.Equal(body, want) {
+			t.Errorf("Request body = %v, want %v", body, want)
+		}
+		fmt.Fprint(w, `{"status": true, "message": "Charges have been queued", "data": {"batch_code": "BCH_rzgg8ac9yzc5s3q", "status": "active"}}`)
+	})
+
+	retry, _, err := client.BulkCharge.RetryFailed(context.Background(), "BCH_180tl7oq7cayggh", &RetryFailedOptions{
+		Reference: func(ch *BulkCharge) string { return ch.GetReference() + "-r1" },
+	})
+	if err != nil {
+		t.Fatalf("BulkCharge.RetryFailed returned error: %v", err)
+	}
+	if retry.From != "BCH_180tl7oq7cayggh" || retry.Batch.GetBatchCode() != "BCH_rzgg8ac9yzc5s3q" {
+		t.Errorf("BulkCharge.RetryFailed linked %s to %+v", retry.From, retry.Batch)
+	}
+	if len(retry.Retried) != 1 || retry.Retried[0].Charge.GetId() != 1 {
+		t.Errorf("BulkCharge.RetryFailed retried %+v, want charge 1", retry.Retried)
+	}
+	var skipped []string
+	for _, s := range retry.Skipped {
+		skipped = append(skipped, fmt.Sprintf("%d: %s", s.Charge.GetId(), s.Reason))
+	}
+	if want := []string{"2: authorization is not reusable", "3: declined with DO NOT HONOR"}; !cmp.Equal(skipped, want) {
+		t.Errorf("BulkCharge.RetryFailed skipped %q, want %q", skipped, want)
+	}
+}
+
+func TestBulkChargeService_RetryFailed_defaultReference(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/bulkcharge/BCH_180tl7oq7cayggh", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": true, "message": "Bulk charge retrieved", "data": {"batch_code": "BCH_180tl7oq7cayggh", "status": "complete", "pending_charges": 0}}`)
+	})
+	mux.HandleFunc("/bulkcharge/BCH_180tl7oq7cayggh/charges", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Bulk charge items retrieved",
+		  "data": [
+			{"id": 1, "status": "failed", "amount": 2500, "reference": "inv-1", "authorization": {"authorization_code": "AUTH_n95vpedf"}},
+			{"id": 2, "status": "failed", "amount": 1500, "authorization": {"authorization_code": "AUTH_ljdt4e4j"}}
+		  ],
+		  "meta": {"total": 2, "perPage": 100, "page": 1, "pageCount": 1}
+		}`)
+	})
+	var references [][]string
+	mux.HandleFunc("/bulkcharge", func(w http.ResponseWriter, r *http.Request) {
+		var body []map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		var refs []string
+		for _, item := range body {
+			refs = append(refs, item["reference"].(string))
+		}
+		references = append(references, refs)
+		fmt.Fprint(w, `{"status": true, "message": "Charges have been queued", "data": {"batch_code": "BCH_rzgg8ac9yzc5s3q"}}`)
+	})
+
+	// Retrying twice sends the same references, which Paystack rejects the
+	// second time instead of charging again.
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.BulkCharge.RetryFailed(context.Background(), "BCH_180tl7oq7cayggh", nil); err != nil {
+			t.Fatalf("BulkCharge.RetryFailed returned error: %v", err)
+		}
+	}
+	refs := []string{"inv-1-retry-BCH180tl7oq7cayggh", "2-retry-BCH180tl7oq7cayggh"}
+	if want := [][]string{refs, refs}; !cmp.Equal(references, want) {
+		t.Errorf("BulkCharge.RetryFailed sent references %q, want %q", references, want)
+	}
+}
+
+func TestBulkChargeService_RetryFailed_pendingBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/bulkcharge/BCH_180tl7oq7cayggh", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": true, "message": "Bulk charge retrieved", "data": {"batch_code": "BCH_180tl7oq7cayggh", "status": "active", "total_charges": 5, "pending_charges": 2}}`)
+	})
+
+	if _, _, err := client.BulkCharge.RetryFailed(context.Background(), "BCH_180tl7oq7cayggh", nil); err == nil {
+		t.Errorf("BulkCharge.RetryFailed of a pending batch returned no error")
+	}
+}
@@ -31,8 +31,8 @@ func TestBulkChargeService_Initiate(t *testing.T) {
 		}`)
 	})
 	bbr := []*BulkBatchRequest{}
-	bbr = append(bbr, &BulkBatchRequest{Authorization: String("AUTH_n95vpedf"), Amount: Int(2500)})
-	bbr = append(bbr, &BulkBatchRequest{Authorization: String("AUTH_ljdt4e4j"), Amount: Int(1500)})
+	bbr = append(bbr, &BulkBatchRequest{Authorization: String("AUTH_n95vpedf"), Amount: NewMoney(2500, NGN)})
+	bbr = append(bbr, &BulkBatchRequest{Authorization: String("AUTH_ljdt4e4j"), Amount: NewMoney(1500, NGN)})
 
 	bc, _, err := client.BulkCharge.Initiate(context.Background(), bbr)
 	if err != nil {
@@ -203,3 +203,121 @@ func TestBulkChargeService_FetchBatchCharges(t *testing.T) {
 		t.Errorf("BulkCharge.FetchBatch returned %+v, want %+v", bc, want)
 	}
 }
+
+func TestBulkChargeService_FetchBatchCharges_options(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/bulkcharge/BCH_180tl7oq7cayggh/charges", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"status": "failed", "page": "2"})
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Bulk charge items retrieved",
+		  "data": [{"bulkcharge": 17, "amount": 1000, "currency": "NGN", "status": "failed", "id": 90}],
+		  "meta": {"total": 1, "skipped": 0, "perPage": 50, "page": 2, "pageCount": 2}
+		}`)
+	})
+
+	charges, _, err := client.BulkCharge.FetchBatchCharges(context.Background(), "BCH_180tl7oq7cayggh",
+		&BulkChargeOptions{ListOptions: ListOptions{Page: 2}, Status: "failed"})
+	if err != nil {
+		t.Fatalf("BulkCharge.FetchBatchCharges returned error: %v", err)
+	}
+	if len(charges) != 1 || charges[0].GetStatus() != "failed" || charges[0].GetId() != 90 {
+		t.Errorf("BulkCharge.FetchBatchCharges returned %+v", charges)
+	}
+}
+
+func TestBulkChargeService_PauseBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var paths []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		paths = append(paths, r.URL.Path)
+		fmt.Fprint(w, `{"status": true, "message": "Bulk charge batch has been paused"}`)
+	}
+	mux.HandleFunc("/bulkcharge/pause/BCH_180tl7oq7cayggh", handler)
+	mux.HandleFunc("/bulkcharge/resume/BCH_180tl7oq7cayggh", handler)
+
+	client.BulkCharge.PauseBatch(context.Background(), "BCH_180tl7oq7cayggh")
+	client.BulkCharge.ResumeBatch(context.Background(), "BCH_180tl7oq7cayggh")
+	if want := []string{"/bulkcharge/pause/BCH_180tl7oq7cayggh", "/bulkcharge/resume/BCH_180tl7oq7cayggh"}; !cmp.Equal(paths, want) {
+		t.Errorf("Requests were sent to %v, want %v", paths, want)
+	}
+}
+
+func TestBulkChargeService_WatchBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// The batch makes progress every other poll.
+	polls := 0
+	pending := []int{3, 3, 1, 0}
+	mux.HandleFunc("/bulkcharge/BCH_180tl7oq7cayggh", func(w http.ResponseWriter, r *http.Request) {
+		status := "active"
+		if pending[polls] == 0 {
+			status = "complete"
+		}
+		fmt.Fprintf(w, `{"status": true, "message": "Bulk charge retrieved", "data": {"batch_code": "BCH_180tl7oq7cayggh", "status": %q, "total_charges": 3, "pending_charges": %d}}`,
+			status, pending[polls])
+		polls++
+	})
+	mux.HandleFunc("/bulkcharge/BCH_180tl7oq7cayggh/charges", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("status") == "failed" {
+			failed := 0
+			if pending[polls-1] < 3 {
+				failed = 1
+			}
+			fmt.Fprintf(w, `{"status": true, "message": "Bulk charge items retrieved", "data": [], "meta": {"total": %d, "perPage": 1, "page": 1, "pageCount": %d}}`, failed, failed)
+			return
+		}
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Bulk charge items retrieved",
+		  "data": [{"status": "success", "id": 1}, {"status": "failed", "id": 2}, {"status": "success", "id": 3}],
+		  "meta": {"total": 3, "perPage": 100, "page": 1, "pageCount": 1}
+		}`)
+	})
+
+	var progress []BatchProgress
+	result, err := client.BulkCharge.WatchBatch(context.Background(), "BCH_180tl7oq7cayggh", &WatchOptions{
+		PollOptions: PollOptions{Interval: time.Millisecond},
+		OnProgress:  func(p BatchProgress) { progress = append(progress, p) },
+	})
+	if err != nil {
+		t.Fatalf("BulkCharge.WatchBatch returned error: %v", err)
+	}
+	want := []BatchProgress{
+		{BatchCode: "BCH_180tl7oq7cayggh", Status: "active", Total: 3, Pending: 3},
+		{BatchCode: "BCH_180tl7oq7cayggh", Status: "active", Total: 3, Charged: 1, Failed: 1, Pending: 1},
+		{BatchCode: "BCH_180tl7oq7cayggh", Status: "complete", Total: 3, Charged: 2, Failed: 1},
+	}
+	if !cmp.Equal(progress, want) {
+		t.Errorf("BulkCharge.WatchBatch reported %+v, want %+v", progress, want)
+	}
+	if len(result.Charged) != 2 || len(result.Failed) != 1 || result.Failed[0].GetId() != 2 {
+		t.Errorf("BulkCharge.WatchBatch returned %+v", result)
+	}
+}
+
+func TestBulkChargeService_WatchBatch_contextDone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/bulkcharge/BCH_180tl7oq7cayggh", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": true, "message": "Bulk charge retrieved", "data": {"batch_code": "BCH_180tl7oq7cayggh", "status": "paused", "total_charges": 3, "pending_charges": 3}}`)
+	})
+	mux.HandleFunc("/bulkcharge/BCH_180tl7oq7cayggh/charges", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": true, "message": "Bulk charge items retrieved", "data": [], "meta": {"total": 0}}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := client.BulkCharge.WatchBatch(ctx, "BCH_180tl7oq7cayggh", &WatchOptions{PollOptions: PollOptions{Interval: time.Millisecond}})
+	if err != context.DeadlineExceeded {
+		t.Errorf("BulkCharge.WatchBatch returned %v, want context.DeadlineExceeded", err)
+	}
+}
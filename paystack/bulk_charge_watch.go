@@ -0,0 +1,126 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+)
+
+// BatchProgress is the progress of a bulk charge batch, as reported by
+// WatchBatch.
+type BatchProgress struct {
+	BatchCode string
+	Status    string
+	Total     int
+	Charged   int
+	Failed    int
+	Pending   int
+}
+
+// Done reports whether every charge of the batch was attempted.
+func (p BatchProgress) Done() bool {
+	return p.Status == "complete" || p.Total > 0 && p.Pending == 0
+}
+
+// BatchResult holds the charges of a completed bulk charge batch, split by
+// outcome.
+type BatchResult struct {
+	Batch *BulkBatch
+
+	// Charged holds the successful charges, and Failed all the others.
+	Charged []*BulkCharge
+	Failed  []*BulkCharge
+}
+
+// WatchOptions configures WatchBatch.
+type WatchOptions struct {
+	PollOptions
+
+	// OnProgress is called with the progress of the batch when it is first
+	// fetched and every time it changes.
+	OnProgress func(BatchProgress)
+}
+
+// WatchBatch polls a bulk charge batch, as set by opt, until all its
+// charges were attempted, then returns them split by outcome. It returns
+// ctx.Err() if ctx ends first. opt may be nil.
+//
+// WatchBatch only needs the batch code, so a batch can be watched again
+// after a restart of the process.
+func (s *BulkChargeService) WatchBatch(ctx context.Context, code string, opt *WatchOptions) (*BatchResult, error) {
+	var o WatchOptions
+	if opt != nil {
+		o = *opt
+	}
+	var (
+		batch *BulkBatch
+		last  *BatchProgress
+	)
+	err := poll(ctx, &o.PollOptions, func() (bool, error) {
+		b, p, err := s.progress(ctx, code)
+		if err != nil {
+			return false, err
+		}
+		batch = b
+		if o.OnProgress != nil && (last == nil || *last != p) {
+			o.OnProgress(p)
+		}
+		last = &p
+		return p.Done(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BatchResult{Batch: batch}
+	for ch, err := range s.FetchAllBatchCharges(ctx, code, &BulkChargeOptions{ListOptions: ListOptions{PerPage: 100}}, nil) {
+		if err != nil {
+			return nil, err
+		}
+		if ch.GetStatus() == "success" {
+			result.Charged = append(result.Charged, ch)
+		} else {
+			result.Failed = append(result.Failed, ch)
+		}
+	}
+	return result, nil
+}
+
+// progress fetches a batch and counts its failed charges.
+func (s *BulkChargeService) progress(ctx context.Context, code string) (*BulkBatch, BatchProgress, error) {
+	b, _, err := s.FetchBatch(ctx, code)
+	if err != nil {
+		return nil, BatchProgress{}, err
+	}
+	failed, err := s.countBatchCharges(ctx, code, "failed")
+	if err != nil {
+		return nil, BatchProgress{}, err
+	}
+	p := BatchProgress{
+		BatchCode: b.GetBatchCode(),
+		Status:    b.GetStatus(),
+		Total:     b.GetTotalCharges(),
+		Failed:    failed,
+		Pending:   b.GetPendingCharges(),
+	}
+	p.Charged = max(p.Total-p.Pending-p.Failed, 0)
+	return b, p, nil
+}
+
+// countBatchCharges returns the number of charges of a batch with the given
+// status, from the pagination metadata of a single item page.
+func (s *BulkChargeService) countBatchCharges(ctx context.Context, code, status string) (int, error) {
+	u, err := addOptions(fmt.Sprintf("bulkcharge/%s/charges", code),
+		&BulkChargeOptions{ListOptions: ListOptions{PerPage: 1}, Status: status})
+	if err != nil {
+		return 0, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return 0, err
+	}
+	lr := new(Envelope[[]*BulkCharge])
+	if _, err := s.client.Do(ctx, req, lr); err != nil {
+		return 0, err
+	}
+	return lr.Meta.Total, nil
+}
@@ -0,0 +1,191 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// maxBulkTransfers is the number of transfers Paystack accepts in one bulk
+// transfer.
+const maxBulkTransfers = 100
+
+// finalTransferStatuses are the statuses a transfer ends in, or stays in
+// until acted upon.
+var finalTransferStatuses = map[string]bool{
+	"success":   true,
+	"failed":    true,
+	"reversed":  true,
+	"abandoned": true,
+	"rejected":  true,
+	"blocked":   true,
+	"otp":       true,
+}
+
+// BulkTransferOptions configures InitiateBulk.
+type BulkTransferOptions struct {
+	// ChunkSize is the number of transfers sent per request, at most and
+	// by default 100.
+	ChunkSize int
+}
+
+// BulkTransferResult is the outcome of one transfer of a bulk transfer.
+type BulkTransferResult struct {
+	// Request is the transfer as requested, with its reference.
+	Request TransferRequest
+
+	// Transfer is the last known state of the transfer, nil if it was not
+	// queued.
+	Transfer *Transfer
+
+	// Err tells why the transfer was not queued. It matches
+	// ErrOutcomeUnknown while it is unknown whether it was queued, until
+	// WaitBulk finds out.
+	Err error
+
+	cause error // the error of the chunk, while Err is ErrOutcomeUnknown.
+}
+
+// Done reports whether the transfer is known to have ended, successfully or
+// not, or to wait for an OTP.
+func (r *BulkTransferResult) Done() bool {
+	if r.Err != nil {
+		return !errors.Is(r.Err, ErrOutcomeUnknown)
+	}
+	return r.Transfer != nil && finalTransferStatuses[r.Transfer.GetStatus()]
+}
+
+// Succeeded reports whether the transfer was paid.
+func (r *BulkTransferResult) Succeeded() bool {
+	return r.Err == nil && r.Transfer.GetStatus() == "success"
+}
+
+// BulkTransferResults holds the outcome of each transfer of a bulk transfer,
+// keyed by reference.
+type BulkTransferResults map[string]*BulkTransferResult
+
+// Pending returns the results of the transfers that are not done, sorted by
+// reference.
+func (rs BulkTransferResults) Pending() []*BulkTransferResult {
+	return rs.filter(func(r *BulkTransferResult) bool { return !r.Done() })
+}
+
+// Failed returns the results of the transfers that are done without having
+// succeeded, sorted by reference.
+func (rs BulkTransferResults) Failed() []*BulkTransferResult {
+	return rs.filter(func(r *BulkTransferResult) bool { return r.Done() && !r.Succeeded() })
+}
+
+func (rs BulkTransferResults) filter(keep func(*BulkTransferResult) bool) []*BulkTransferResult {
+	var out []*BulkTransferResult
+	for _, r := range rs {
+		if keep(r) {
+			out = append(out, r)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return *out[i].Request.Reference < *out[j].Request.Reference })
+	return out
+}
+
+// InitiateBulk queues any number of transfers, in chunks of at most 100, and
+// returns the outcome of each keyed by reference. Transfers without a
+// reference are given one, and references must be unique.
+//
+// A chunk that fails sets Err on each of its transfers, and the next chunks
+// are still sent. When it is unknown whether a transfer was queued, as when
+// the connection dropped or the response left it out, Err matches
+// ErrOutcomeUnknown and WaitBulk verifies it. The returned error only reports an invalid request.
+func (s *TransferService) InitiateBulk(ctx context.Context, t *BulkTransferRequest, opt *BulkTransferOptions) (BulkTransferResults, error) {
+	size := maxBulkTransfers
+	if opt != nil && opt.ChunkSize > 0 && opt.ChunkSize < maxBulkTransfers {
+		size = opt.ChunkSize
+	}
+
+	results := make(BulkTransferResults, len(t.Transfers))
+	transfers := make([]TransferRequest, len(t.Transfers))
+	for i, tr := range t.Transfers {
+		s.client.ensureReference(&tr.Reference)
+		if _, ok := results[*tr.Reference]; ok {
+			return nil, fmt.Errorf("paystack: duplicate transfer reference %s", *tr.Reference)
+		}
+		transfers[i] = tr
+		results[*tr.Reference] = &BulkTransferResult{Request: tr}
+	}
+
+	for start := 0; start < len(transfers); start += size {
+		chunk := transfers[start:min(start+size, len(transfers))]
+		if err := ctx.Err(); err != nil {
+			setBulkError(results, chunk, err, false)
+			continue
+		}
+		req := *t
+		req.Transfers = chunk
+		queued, resp, err := s.InitiateBulkTransfer(ctx, &req)
+		if err != nil {
+			setBulkError(results, chunk, err, isAmbiguous(resp, err))
+			continue
+		}
+		for i := range queued {
+			ref := queued[i].GetReference()
+			if results[ref] == nil && i < len(chunk) {
+				// Paystack returns the transfers in the order of the request.
+				ref = *chunk[i].Reference
+			}
+			if r := results[ref]; r != nil {
+				r.Transfer = &queued[i]
+			}
+		}
+		for _, tr := range chunk {
+			if r := results[*tr.Reference]; r.Transfer == nil {
+				r.cause = fmt.Errorf("paystack: transfer %s missing from the bulk transfer response", *tr.Reference)
+				r.Err = fmt.Errorf("%w: %w", ErrOutcomeUnknown, r.cause)
+			}
+		}
+	}
+	return results, nil
+}
+
+// setBulkError sets err on the results of the transfers of chunk, wrapped
+// in ErrOutcomeUnknown when the chunk may have been queued nonetheless.
+func setBulkError(results BulkTransferResults, chunk []TransferRequest, err error, ambiguous bool) {
+	for _, tr := range chunk {
+		r := results[*tr.Reference]
+		r.Err = err
+		if ambiguous {
+			r.Err = fmt.Errorf("%w: %w", ErrOutcomeUnknown, err)
+			r.cause = err
+		}
+	}
+}
+
+// WaitBulk verifies the pending transfers of results by reference, as set
+// by opt, until they are all done, updating results as it goes. It returns
+// ctx.Err() if ctx ends first. opt may be nil.
+//
+// A transfer whose outcome was unknown and is not found was not queued: its
+// Err is set to the error of its chunk, and it can be initiated again. Any
+// other error that retrying will not fix is set on Err, ending the wait for
+// that transfer, and other errors are returned.
+func (s *TransferService) WaitBulk(ctx context.Context, results BulkTransferResults, opt *PollOptions) error {
+	return poll(ctx, opt, func() (bool, error) {
+		pending := results.Pending()
+		for _, r := range pending {
+			t, resp, err := s.Verify(ctx, *r.Request.Reference)
+			var er *ErrorResponse
+			switch {
+			case err == nil:
+				r.Transfer, r.Err, r.cause = t, nil, nil
+			case ctx.Err() != nil:
+				return false, ctx.Err()
+			case r.cause != nil && isNotFound(err):
+				r.Err, r.cause = r.cause, nil
+			case errors.As(err, &er) && !shouldRetry(resp, err):
+				r.Err, r.cause = err, nil
+			default:
+				return false, err
+			}
+		}
+		return len(results.Pending()) == 0, nil
+	})
+}
@@ -0,0 +1,118 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTransferService_InitiateBulk(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var chunks []int
+	mux.HandleFunc("/transfer/bulk", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if r.URL.RawQuery != "" {
+			t.Errorf("Request query = %q, want none", r.URL.RawQuery)
+		}
+		var body struct {
+			Source    string
+			Transfers []map[string]interface{}
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		chunks = append(chunks, len(body.Transfers))
+		if len(chunks) == 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"status": false, "message": "Your balance is not enough to fulfil this request"}`)
+			return
+		}
+		var data []string
+		for _, tr := range body.Transfers {
+			// Without the reference, the transfers are matched by position.
+			data = append(data, fmt.Sprintf(`{"recipient": %q, "amount": 500, "transfer_code": "TRF_%s", "status": "received"}`,
+				tr["recipient"], tr["reference"]))
+		}
+		fmt.Fprintf(w, `{"status": true, "message": "%d transfers queued.", "data": [%s]}`, len(data), strings.Join(data, ","))
+	})
+
+	req := &BulkTransferRequest{Source: String("balance")}
+	for i := 0; i < 5; i++ {
+		req.Transfers = append(req.Transfers, TransferRequest{
+			Recipient: String("RCP_db342dvqvz9qcrn"),
+			Amount:    NewMoney(500, NGN),
+			Reference: String(fmt.Sprintf("ref-%d", i)),
+		})
+	}
+	results, err := client.Transfer.InitiateBulk(context.Background(), req, &BulkTransferOptions{ChunkSize: 2})
+	if err != nil {
+		t.Fatalf("Transfer.InitiateBulk returned error: %v", err)
+	}
+	if fmt.Sprint(chunks) != "[2 2 1]" {
+		t.Errorf("Transfer.InitiateBulk sent chunks of %v, want [2 2 1]", chunks)
+	}
+	if got := results["ref-4"].Transfer.GetTransferCode(); got != "TRF_ref-4" {
+		t.Errorf("ref-4 has transfer code %q, want TRF_ref-4", got)
+	}
+	if r := results["ref-2"]; !errors.Is(r.Err, ErrInsufficientBalance) || !r.Done() || r.Succeeded() {
+		t.Errorf("ref-2 = %+v, want a failure matching ErrInsufficientBalance", r)
+	}
+	if pending := results.Pending(); len(pending) != 3 || pending[0].Request.GetReference() != "ref-0" {
+		t.Errorf("Pending returned %d results, want the 3 received transfers", len(pending))
+	}
+
+	req.Transfers[1].Reference = String("ref-0")
+	if _, err := client.Transfer.InitiateBulk(context.Background(), req, nil); err == nil {
+		t.Errorf("Transfer.InitiateBulk with duplicate references returned no error")
+	}
+}
+
+func TestTransferService_WaitBulk(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transfer/bulk", func(w http.ResponseWriter, r *http.Request) {
+		// The response leaves out ref-1.
+		fmt.Fprint(w, `{"status": true, "message": "1 transfers queued.", "data": [
+		  {"reference": "ref-0", "transfer_code": "TRF_0", "status": "received"}
+		]}`)
+	})
+	mux.HandleFunc("/transfer/verify/ref-0", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"status": false, "message": "Invalid transfer reference"}`)
+	})
+	mux.HandleFunc("/transfer/verify/ref-1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status": false, "message": "Transfer not found"}`)
+	})
+
+	req := &BulkTransferRequest{Source: String("balance"), Transfers: []TransferRequest{
+		{Recipient: String("RCP_db342dvqvz9qcrn"), Amount: NewMoney(500, NGN), Reference: String("ref-0")},
+		{Recipient: String("RCP_db342dvqvz9qcrn"), Amount: NewMoney(500, NGN), Reference: String("ref-1")},
+	}}
+	results, err := client.Transfer.InitiateBulk(context.Background(), req, nil)
+	if err != nil {
+		t.Fatalf("Transfer.InitiateBulk returned error: %v", err)
+	}
+	if r := results["ref-1"]; !errors.Is(r.Err, ErrOutcomeUnknown) || r.Done() {
+		t.Fatalf("ref-1 = %+v, want a pending result matching ErrOutcomeUnknown", r)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Transfer.WaitBulk(ctx, results, &PollOptions{Interval: time.Millisecond}); err != nil {
+		t.Fatalf("Transfer.WaitBulk returned error: %v", err)
+	}
+	var er *ErrorResponse
+	if r := results["ref-0"]; !errors.As(r.Err, &er) || er.Response.StatusCode != http.StatusBadRequest || !r.Done() {
+		t.Errorf("ref-0 = %+v, want the 400 of Verify", r)
+	}
+	if r := results["ref-1"]; r.Err == nil || errors.Is(r.Err, ErrOutcomeUnknown) || !r.Done() {
+		t.Errorf("ref-1 = %+v, want a failure that was not queued", r)
+	}
+}
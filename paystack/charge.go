@@ -2,18 +2,55 @@ package paystack
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"time"
 )
 
+// ChargeService handles the communication with the Charge related parts of
+// the Paystack API, which charges customers through any payment channel.
 type ChargeService service
 
+// ChargeRequest starts a charge. Besides the email and amount, it sets the
+// payment channel: one of Card, Bank, USSD, MobileMoney, QR, BankTransfer,
+// ApplePay or AuthorizationCode.
 type ChargeRequest struct {
-	Email             *string  `json:"email, omitempty"`
-	Card              Card     `json:"card, omitempty"`
-	Bank              Bank     `json:"bank, omitempty"`
-	AuthorizationCode *string  `json:"authorization_code, omitempty"`
-	Pin               *string  `json:"pin, omitempty"`
-	Metadata          Metadata `json:"metadata, omitempty"`
+	Email             *string       `json:"email, omitempty"`
+	Amount            *Money        `json:"amount,omitempty"`
+	Currency          *Currency     `json:"currency,omitempty"`
+	Reference         *string       `json:"reference,omitempty"`
+	Card              Card          `json:"card,omitempty"`
+	Bank              Bank          `json:"bank,omitempty"`
+	USSD              *USSD         `json:"ussd,omitempty"`
+	MobileMoney       *MobileMoney  `json:"mobile_money,omitempty"`
+	QR                *QR           `json:"qr,omitempty"`
+	BankTransfer      *BankTransfer `json:"bank_transfer,omitempty"`
+	ApplePay          *ApplePay     `json:"apple_pay,omitempty"`
+	AuthorizationCode *string       `json:"authorization_code,omitempty"`
+	Pin               *string       `json:"pin,omitempty"`
+	Birthday          *string       `json:"birthday,omitempty"`
+	DeviceID          *string       `json:"device_id,omitempty"`
+	Metadata          Metadata      `json:"metadata, omitempty"`
+	SplitCode         *string       `json:"split_code,omitempty"`
+}
+
+// MarshalJSON encodes r, leaving out Card and Bank when they are unset, since
+// Paystack picks the payment channel from the fields that are sent.
+func (r ChargeRequest) MarshalJSON() ([]byte, error) {
+	type request ChargeRequest
+	v := struct {
+		request
+		Card *Card `json:"card,omitempty"`
+		Bank *Bank `json:"bank,omitempty"`
+	}{request: request(r)}
+	if r.Card != (Card{}) {
+		v.Card = &r.Card
+	}
+	if !reflect.ValueOf(r.Bank).IsZero() {
+		v.Bank = &r.Bank
+	}
+	return json.Marshal(v)
 }
 
 type Card struct {
@@ -23,6 +60,64 @@ type Card struct {
 	ExpiryYear  *string `json:"expiry_year, omitempty"`
 }
 
+// USSD charges through the USSD code of a bank. Type is the code of the
+// bank, such as "737" for GTBank, "919" for UBA, "822" for Sterling Bank or
+// "966" for Zenith Bank.
+type USSD struct {
+	Type *string `json:"type,omitempty"`
+}
+
+// MobileMoneyProvider enumerates the mobile money operators
+type MobileMoneyProvider int
+
+const (
+	MobileMoneyMTN MobileMoneyProvider = 1 + iota
+	MobileMoneyVodafone
+	MobileMoneyAirtelTigo
+	MobileMoneyMPesa
+)
+
+var mobileMoneyProviders = [...]string{
+	"mtn",
+	"vod",
+	"atl",
+	"mpesa",
+}
+
+// String returns the API name of a MobileMoneyProvider, or "" if it is unset
+func (m MobileMoneyProvider) String() string { return enumString(mobileMoneyProviders[:], int(m)) }
+
+func (m MobileMoneyProvider) MarshalText() ([]byte, error) { return []byte(m.String()), nil }
+
+func (m *MobileMoneyProvider) UnmarshalText(text []byte) error {
+	*m = MobileMoneyProvider(enumParse(mobileMoneyProviders[:], string(text)))
+	return nil
+}
+
+// MobileMoney charges a mobile money wallet, in Ghana or Kenya.
+type MobileMoney struct {
+	Phone    *string             `json:"phone,omitempty"`
+	Provider MobileMoneyProvider `json:"provider,omitempty"`
+}
+
+// QR charges by displaying a QR code to scan. Provider is "visa" or
+// "scan-to-pay".
+type QR struct {
+	Provider *string `json:"provider,omitempty"`
+}
+
+// BankTransfer charges by having the customer transfer the amount to a
+// temporary account, returned in the Transaction, before AccountExpiresAt.
+type BankTransfer struct {
+	AccountExpiresAt *time.Time `json:"account_expires_at,omitempty"`
+}
+
+// ApplePay charges with the payment token returned by Apple Pay on the
+// device of the customer.
+type ApplePay struct {
+	PaymentToken *string `json:"payment_token,omitempty"`
+}
+
 type PinRequest struct {
 	Pin       *string `json:"pin, omitempty"`
 	Reference *string `json:"reference, omitempty"`
@@ -34,7 +129,7 @@ type OTPRequest struct {
 }
 
 type PhoneRequest struct {
-	OTP       *string `json:"otp, omitempty"`
+	Phone     *string `json:"phone,omitempty"`
 	Reference *string `json:"reference, omitempty"`
 }
 
@@ -43,6 +138,64 @@ type BirthdayRequest struct {
 	Reference *string `json:"reference, omitempty"`
 }
 
+// AddressRequest submits the billing address requested by a charge.
+type AddressRequest struct {
+	Address   *string `json:"address,omitempty"`
+	City      *string `json:"city,omitempty"`
+	State     *string `json:"state,omitempty"`
+	ZipCode   *string `json:"zipcode,omitempty"`
+	Reference *string `json:"reference,omitempty"`
+}
+
+// ChargeAction enumerates what a charge needs from the customer to go on
+type ChargeAction int
+
+const (
+	ChargeSendPIN ChargeAction = 1 + iota
+	ChargeSendOTP
+	ChargeSendPhone
+	ChargeSendBirthday
+	ChargeSendAddress
+	ChargeOpenURL
+	ChargePayOffline
+)
+
+var chargeActions = [...]string{
+	"send_pin",
+	"send_otp",
+	"send_phone",
+	"send_birthday",
+	"send_address",
+	"open_url",
+	"pay_offline",
+}
+
+// String returns the API name of a ChargeAction, or "" if it is unset
+func (c ChargeAction) String() string { return enumString(chargeActions[:], int(c)) }
+
+func (c ChargeAction) MarshalText() ([]byte, error) { return []byte(c.String()), nil }
+
+func (c *ChargeAction) UnmarshalText(text []byte) error {
+	*c = ChargeAction(enumParse(chargeActions[:], string(text)))
+	return nil
+}
+
+// NextAction returns what the charge needs from the customer to go on, or 0
+// if it needs nothing, as when it succeeded, failed or is pending:
+//
+//   - ChargeSendPIN, ChargeSendOTP, ChargeSendPhone, ChargeSendBirthday and
+//     ChargeSendAddress: submit the value with the matching ChargeService
+//     method.
+//   - ChargeOpenURL: send the customer to URL.
+//   - ChargePayOffline: show DisplayText, USSDCode, QRCode or the account
+//     to transfer to, then poll CheckPending.
+func (t *Transaction) NextAction() ChargeAction {
+	if t.GetStatus() == "pending_bank_transfer" {
+		return ChargePayOffline
+	}
+	return ChargeAction(enumParse(chargeActions[:], t.GetStatus()))
+}
+
 // Tokenize
 //
 // Paystack API reference:
@@ -53,132 +206,102 @@ func (s *ChargeService) Tokenize(ctx context.Context, request *ChargeRequest) (*
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Authorization])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(Authorization)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
 
-// Charge
+// Charge starts a charge through the payment channel set in the request.
+// Unless it completes at once, the returned Transaction tells what it needs
+// next, see Transaction.NextAction.
 //
 // Paystack API reference:
 // https://developers.paystack.co/reference#charge
 func (s *ChargeService) Charge(ctx context.Context, request *ChargeRequest) (*Transaction, *Response, error) {
-	u := fmt.Sprintf("charge")
-	req, err := s.client.NewRequest("POST", u, request)
-	if err != nil {
-		return nil, nil, err
+	if request != nil {
+		s.client.setReference(&request.Reference)
 	}
-	r := new(StandardResponse)
-	resp, err := s.client.Do(ctx, req, r)
-	if err != nil {
-		return nil, resp, err
-	}
-	m := new(Transaction)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return s.submit(ctx, "charge", request)
 }
 
-// SubmitPIN
+// SubmitPIN submits the PIN of the card, when the charge needs ChargeSendPIN.
 //
 // Paystack API reference:
 // https://developers.paystack.co/reference#submit-pin
 func (s *ChargeService) SubmitPIN(ctx context.Context, request *PinRequest) (*Transaction, *Response, error) {
-	u := fmt.Sprintf("charge/submit_pin")
-	req, err := s.client.NewRequest("POST", u, request)
-	if err != nil {
-		return nil, nil, err
-	}
-	r := new(StandardResponse)
-	resp, err := s.client.Do(ctx, req, r)
-	if err != nil {
-		return nil, resp, err
-	}
-	m := new(Transaction)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return s.submit(ctx, "charge/submit_pin", request)
 }
 
-// SubmitOTP
+// SubmitOTP submits the OTP sent to the customer, when the charge needs ChargeSendOTP.
 //
 // Paystack API reference:
 // https://developers.paystack.co/reference#submit-otp
 func (s *ChargeService) SubmitOTP(ctx context.Context, request *OTPRequest) (*Transaction, *Response, error) {
-	u := fmt.Sprintf("charge/submit_pin")
-	req, err := s.client.NewRequest("POST", u, request)
-	if err != nil {
-		return nil, nil, err
-	}
-	r := new(StandardResponse)
-	resp, err := s.client.Do(ctx, req, r)
-	if err != nil {
-		return nil, resp, err
-	}
-	m := new(Transaction)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return s.submit(ctx, "charge/submit_otp", request)
 }
 
-// SubmitPhone
+// SubmitPhone submits the phone number of the customer, when the charge needs
+// ChargeSendPhone.
 //
 // Paystack API reference:
 // https://developers.paystack.co/reference#submit-phone
 func (s *ChargeService) SubmitPhone(ctx context.Context, request *PhoneRequest) (*Transaction, *Response, error) {
-	u := fmt.Sprintf("charge/submit_pin")
-	req, err := s.client.NewRequest("POST", u, request)
-	if err != nil {
-		return nil, nil, err
-	}
-	r := new(StandardResponse)
-	resp, err := s.client.Do(ctx, req, r)
-	if err != nil {
-		return nil, resp, err
-	}
-	m := new(Transaction)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return s.submit(ctx, "charge/submit_phone", request)
 }
 
-// SubmitBirthday
+// SubmitBirthday submits the birthday of the customer, as YYYY-MM-DD, when the charge
+// needs ChargeSendBirthday.
 //
 // Paystack API reference:
 // https://developers.paystack.co/reference#submit-birthday
 func (s *ChargeService) SubmitBirthday(ctx context.Context, request *BirthdayRequest) (*Transaction, *Response, error) {
-	u := fmt.Sprintf("charge/submit_pin")
-	req, err := s.client.NewRequest("POST", u, request)
+	return s.submit(ctx, "charge/submit_birthday", request)
+}
+
+// SubmitAddress submits the billing address of the customer, when the charge needs
+// ChargeSendAddress.
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#submit-address
+func (s *ChargeService) SubmitAddress(ctx context.Context, request *AddressRequest) (*Transaction, *Response, error) {
+	return s.submit(ctx, "charge/submit_address", request)
+}
+
+// CheckPending fetches the state of a charge, to find out how a pending
+// charge or one paid offline ended.
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#check-pending-charge
+func (s *ChargeService) CheckPending(ctx context.Context, reference *string) (*Transaction, *Response, error) {
+	if reference == nil {
+		return nil, nil, errNilRequest
+	}
+	u := fmt.Sprintf("charge/%s", *reference)
+	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Transaction])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(Transaction)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
 
-// CheckPending
-//
-// Paystack API reference:
-// https://developers.paystack.co/reference#check-pending-charge
-func (s *ChargeService) CheckPending(ctx context.Context, reference *string) (*Transaction, *Response, error) {
-	u := fmt.Sprintf("charge/submit_pin")
-	req, err := s.client.NewRequest("GET", u, reference)
+// submit posts request to the charge endpoint u.
+func (s *ChargeService) submit(ctx context.Context, u string, request interface{}) (*Transaction, *Response, error) {
+	req, err := s.client.NewRequest("POST", u, request)
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Transaction])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(Transaction)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
@@ -0,0 +1,202 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrUnexpectedInput is returned by ChargeFlow when it is given an input
+// that the charge does not need at its current step.
+var ErrUnexpectedInput = errors.New("paystack: input not needed by the charge")
+
+// finalChargeStatuses are the statuses a charge ends in.
+var finalChargeStatuses = map[string]bool{
+	"success":   true,
+	"failed":    true,
+	"abandoned": true,
+	"reversed":  true,
+	"timeout":   true,
+}
+
+// ChargeFlow drives a charge through the steps it needs before it
+// completes: PIN, OTP, phone, birthday and address submissions, 3DS
+// redirects, and payments made offline. It tracks the current step from
+// every response, and tells what it needs next with Next.
+//
+// A ChargeFlow is serialisable with encoding/json, so that a web session can
+// store it between the HTTP requests of the customer and resume it with
+// ChargeService.ResumeFlow.
+type ChargeFlow struct {
+	Reference   string       `json:"reference"`
+	Status      string       `json:"status"`
+	Next        ChargeAction `json:"next,omitempty"`
+	DisplayText string       `json:"display_text,omitempty"`
+	URL         string       `json:"url,omitempty"`
+
+	// Transaction is the charge as last returned by Paystack. It is not
+	// serialised.
+	Transaction *Transaction `json:"-"`
+
+	s *ChargeService
+}
+
+// StartFlow starts a charge and returns the flow driving it. The request is
+// given a reference if it has none, so that the charge can be checked even
+// when starting it fails.
+func (s *ChargeService) StartFlow(ctx context.Context, request *ChargeRequest) (*ChargeFlow, *Response, error) {
+	if request == nil {
+		return nil, nil, errNilRequest
+	}
+	s.client.ensureReference(&request.Reference)
+	tr, resp, err := s.Charge(ctx, request)
+	if err != nil {
+		return nil, resp, err
+	}
+	f := &ChargeFlow{Reference: *request.Reference, s: s}
+	f.update(tr)
+	return f, resp, nil
+}
+
+// ResumeFlow attaches a deserialised flow to the service so that it can go
+// on, and returns it. A nil flow is returned as is, and its methods that
+// call Paystack return an error.
+func (s *ChargeService) ResumeFlow(f *ChargeFlow) *ChargeFlow {
+	if f == nil {
+		return nil
+	}
+	f.s = s
+	return f
+}
+
+// Done reports whether the charge ended, successfully or not.
+func (f *ChargeFlow) Done() bool {
+	return finalChargeStatuses[f.Status]
+}
+
+// Succeeded reports whether the charge ended successfully.
+func (f *ChargeFlow) Succeeded() bool {
+	return f.Status == "success"
+}
+
+// NeedsInput reports whether the charge waits for an input of the customer,
+// to be given with Submit or SubmitAddress.
+func (f *ChargeFlow) NeedsInput() bool {
+	switch f.Next {
+	case ChargeSendPIN, ChargeSendOTP, ChargeSendPhone, ChargeSendBirthday, ChargeSendAddress:
+		return true
+	}
+	return false
+}
+
+// Submit submits the PIN, OTP, phone number or birthday the charge needs,
+// as told by Next, and moves the flow to the next step. It returns
+// ErrUnexpectedInput if the charge needs none of them. When Paystack rejects
+// the input, as with ErrInvalidOTP, the flow stays at the same step.
+func (f *ChargeFlow) Submit(ctx context.Context, input string) (*Response, error) {
+	if err := f.attached(); err != nil {
+		return nil, err
+	}
+	ref := String(f.Reference)
+	var (
+		tr   *Transaction
+		resp *Response
+		err  error
+	)
+	switch f.Next {
+	case ChargeSendPIN:
+		tr, resp, err = f.s.SubmitPIN(ctx, &PinRequest{Pin: String(input), Reference: ref})
+	case ChargeSendOTP:
+		tr, resp, err = f.s.SubmitOTP(ctx, &OTPRequest{OTP: String(input), Reference: ref})
+	case ChargeSendPhone:
+		tr, resp, err = f.s.SubmitPhone(ctx, &PhoneRequest{Phone: String(input), Reference: ref})
+	case ChargeSendBirthday:
+		tr, resp, err = f.s.SubmitBirthday(ctx, &BirthdayRequest{Birthday: String(input), Reference: ref})
+	default:
+		return nil, f.unexpected()
+	}
+	if err != nil {
+		return resp, err
+	}
+	f.update(tr)
+	return resp, nil
+}
+
+// SubmitAddress submits the billing address the charge needs when Next is
+// ChargeSendAddress, and moves the flow to the next step.
+func (f *ChargeFlow) SubmitAddress(ctx context.Context, address *AddressRequest) (*Response, error) {
+	if err := f.attached(); err != nil {
+		return nil, err
+	}
+	if address == nil {
+		return nil, errNilRequest
+	}
+	if f.Next != ChargeSendAddress {
+		return nil, f.unexpected()
+	}
+	a := *address
+	a.Reference = String(f.Reference)
+	tr, resp, err := f.s.SubmitAddress(ctx, &a)
+	if err != nil {
+		return resp, err
+	}
+	f.update(tr)
+	return resp, nil
+}
+
+// Refresh checks the charge once with CheckPending and updates the flow.
+func (f *ChargeFlow) Refresh(ctx context.Context) (*Response, error) {
+	if err := f.attached(); err != nil {
+		return nil, err
+	}
+	tr, resp, err := f.s.CheckPending(ctx, &f.Reference)
+	if err != nil {
+		return resp, err
+	}
+	f.update(tr)
+	return resp, nil
+}
+
+// Wait polls CheckPending, as set by opt, until the charge is done or needs
+// an input of the customer, which is how a charge continues after a 3DS
+// redirect or an offline payment. Transient errors, such as a 5xx, are
+// retried at the next check and other errors end the wait. It returns
+// ctx.Err() if ctx ends first. opt may be nil.
+func (f *ChargeFlow) Wait(ctx context.Context, opt *PollOptions) (*Response, error) {
+	var resp *Response
+	err := poll(ctx, opt, func() (bool, error) {
+		if f.Done() || f.NeedsInput() {
+			return true, nil
+		}
+		var err error
+		resp, err = f.Refresh(ctx)
+		return f.Done() || f.NeedsInput(), err
+	})
+	return resp, err
+}
+
+// update moves the flow to the step of tr.
+func (f *ChargeFlow) update(tr *Transaction) {
+	f.Transaction = tr
+	f.Status = tr.GetStatus()
+	f.Next = tr.NextAction()
+	f.DisplayText = tr.GetDisplayText()
+	f.URL = tr.GetURL()
+}
+
+func (f *ChargeFlow) attached() error {
+	if f == nil {
+		return errors.New("paystack: ChargeFlow is nil")
+	}
+	if f.s == nil {
+		return errors.New("paystack: ChargeFlow not attached to a client, see ChargeService.ResumeFlow")
+	}
+	return nil
+}
+
+func (f *ChargeFlow) unexpected() error {
+	if f.Next == 0 {
+		return fmt.Errorf("%w: charge %s is %s", ErrUnexpectedInput, f.Reference, f.Status)
+	}
+	return fmt.Errorf("%w: charge %s needs %s", ErrUnexpectedInput, f.Reference, f.Next)
+}
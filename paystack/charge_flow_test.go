@@ -0,0 +1,170 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestChargeFlow(t *testing.T) {
+	setup()
+	defer teardown()
+
+	respond := func(w http.ResponseWriter, status string) {
+		fmt.Fprintf(w, `{"status": true, "message": "Charge attempted", "data": {"reference": "ref-1", "status": %q}}`, status)
+	}
+	mux.HandleFunc("/charge", func(w http.ResponseWriter, r *http.Request) { respond(w, "send_pin") })
+	mux.HandleFunc("/charge/submit_pin", func(w http.ResponseWriter, r *http.Request) { respond(w, "send_otp") })
+	mux.HandleFunc("/charge/submit_otp", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["otp"] != "123456" || body["reference"] != "ref-1" {
+			t.Errorf("Charge.SubmitOTP request body = %v", body)
+		}
+		respond(w, "pending")
+	})
+	checks := 0
+	mux.HandleFunc("/charge/ref-1", func(w http.ResponseWriter, r *http.Request) {
+		if checks++; checks < 3 {
+			respond(w, "pending")
+			return
+		}
+		respond(w, "success")
+	})
+
+	ctx := context.Background()
+	flow, _, err := client.Charge.StartFlow(ctx, &ChargeRequest{
+		Email:     String("bojack@horsinaround.com"),
+		Amount:    NewMoney(10000, NGN),
+		Card:      Card{Number: String("5078 5078 5078 5078 12")},
+		Reference: String("ref-1"),
+	})
+	if err != nil {
+		t.Fatalf("Charge.StartFlow returned error: %v", err)
+	}
+	if flow.Next != ChargeSendPIN || !flow.NeedsInput() {
+		t.Fatalf("Charge.StartFlow returned a flow at %v, want send_pin", flow.Next)
+	}
+	if _, err := flow.Submit(ctx, "1234"); err != nil {
+		t.Fatalf("ChargeFlow.Submit returned error: %v", err)
+	}
+
+	// The flow is stored in the session of the customer until the OTP comes.
+	state, err := json.Marshal(flow)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	if want := `{"reference":"ref-1","status":"send_otp","next":"send_otp"}`; string(state) != want {
+		t.Errorf("json.Marshal returned %s, want %s", state, want)
+	}
+	resumed := new(ChargeFlow)
+	json.Unmarshal(state, resumed)
+	flow = client.Charge.ResumeFlow(resumed)
+
+	if _, err := flow.SubmitAddress(ctx, &AddressRequest{}); !errors.Is(err, ErrUnexpectedInput) {
+		t.Errorf("ChargeFlow.SubmitAddress returned %v, want ErrUnexpectedInput", err)
+	}
+	if _, err := flow.Submit(ctx, "123456"); err != nil {
+		t.Fatalf("ChargeFlow.Submit returned error: %v", err)
+	}
+	if _, err := flow.Wait(ctx, &PollOptions{Interval: time.Millisecond}); err != nil {
+		t.Fatalf("ChargeFlow.Wait returned error: %v", err)
+	}
+	if !flow.Done() || !flow.Succeeded() || checks != 3 {
+		t.Errorf("ChargeFlow.Wait ended at %q after %d checks, want success after 3", flow.Status, checks)
+	}
+	if _, err := flow.Submit(ctx, "123456"); !errors.Is(err, ErrUnexpectedInput) {
+		t.Errorf("ChargeFlow.Submit after success returned %v, want ErrUnexpectedInput", err)
+	}
+}
+
+func TestChargeFlow_Wait_contextDone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/charge/ref-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": true, "message": "Reference check successful", "data": {"reference": "ref-1", "status": "open_url", "url": "https://standard.paystack.co/close"}}`)
+	})
+
+	flow := client.Charge.ResumeFlow(&ChargeFlow{Reference: "ref-1", Status: "open_url", Next: ChargeOpenURL})
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := flow.Wait(ctx, &PollOptions{Interval: time.Millisecond}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("ChargeFlow.Wait returned %v, want context.DeadlineExceeded", err)
+	}
+	if flow.URL != "https://standard.paystack.co/close" || flow.Next != ChargeOpenURL {
+		t.Errorf("ChargeFlow.Wait left the flow at %+v", flow)
+	}
+}
+
+func TestChargeFlow_Wait_transientError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	checks := 0
+	mux.HandleFunc("/charge/ref-1", func(w http.ResponseWriter, r *http.Request) {
+		switch checks++; checks {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"status": false, "message": "Service unavailable"}`)
+		case 2:
+			fmt.Fprint(w, `{"status": true, "message": "Reference check successful", "data": {"reference": "ref-1", "status": "success"}}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"status": false, "message": "Invalid reference"}`)
+		}
+	})
+
+	ctx := context.Background()
+	flow := client.Charge.ResumeFlow(&ChargeFlow{Reference: "ref-1", Status: "pending"})
+	if _, err := flow.Wait(ctx, &PollOptions{Interval: time.Millisecond}); err != nil || !flow.Succeeded() || checks != 2 {
+		t.Errorf("ChargeFlow.Wait returned %v at %q after %d checks, want success after 2", err, flow.Status, checks)
+	}
+
+	flow = client.Charge.ResumeFlow(&ChargeFlow{Reference: "ref-1", Status: "pending"})
+	var br *BadRequestError
+	if _, err := flow.Wait(ctx, &PollOptions{Interval: time.Millisecond}); !errors.As(err, &br) || checks != 3 {
+		t.Errorf("ChargeFlow.Wait returned %v after %d checks, want the BadRequestError of the 3rd", err, checks)
+	}
+}
+
+func TestChargeService_StartFlow_nilRequest(t *testing.T) {
+	if _, _, err := NewClient(nil).Charge.StartFlow(context.Background(), nil); err == nil {
+		t.Error("Charge.StartFlow with a nil request returned no error")
+	}
+}
+
+func TestChargeService_ResumeFlow_nil(t *testing.T) {
+	client := NewClient(nil)
+	flow := client.Charge.ResumeFlow(nil)
+	if flow != nil {
+		t.Fatalf("Charge.ResumeFlow(nil) returned %+v", flow)
+	}
+	if _, err := flow.Submit(context.Background(), "123456"); err == nil {
+		t.Error("ChargeFlow.Submit on a nil flow returned no error")
+	}
+}
+
+func TestChargeFlow_SubmitAddress_nil(t *testing.T) {
+	client := NewClient(nil)
+	flow := client.Charge.ResumeFlow(&ChargeFlow{Reference: "T1", Status: "send_address", Next: ChargeSendAddress})
+	if _, err := flow.SubmitAddress(context.Background(), nil); err == nil {
+		t.Error("ChargeFlow.SubmitAddress with a nil address returned no error")
+	}
+}
+
+func TestPollOptions_delay(t *testing.T) {
+	o := &PollOptions{Interval: time.Second, MaxInterval: 5 * time.Second}
+	for n, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := o.delay(n); got != want {
+			t.Errorf("delay(%d) = %v, want %v", n, got, want)
+		}
+	}
+	if got := (*PollOptions)(nil).delay(0); got != defaultPollInterval {
+		t.Errorf("delay of nil options = %v, want %v", got, defaultPollInterval)
+	}
+}
@@ -0,0 +1,165 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestChargeService_Charge_mobileMoney(t *testing.T) {
+	setup()
+	defer teardown()
+	AutoReference(func() string { return "ref-1" })(client)
+
+	mux.HandleFunc("/charge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		want := map[string]interface{}{
+			"amount":       float64(10000),
+			"currency":     "GHS",
+			"reference":    "ref-1",
+			"mobile_money": map[string]interface{}{"phone": "0551234987", "provider": "vod"},
+		}
+		for k, v := range want {
+			if !cmp.Equal(body[k], v) {
+				t.Errorf("Request body %s = %v, want %v", k, body[k], v)
+			}
+		}
+		for _, k := range []string{"card", "bank", "ussd", "authorization_code"} {
+			if _, ok := body[k]; ok {
+				t.Errorf("Request body has %s: %v", k, body)
+			}
+		}
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Charge attempted",
+		  "data": {
+			"reference": "ref-1",
+			"status": "send_otp",
+			"display_text": "Please enter the OTP sent to your phone"
+		  }
+		}`)
+	})
+
+	tr, _, err := client.Charge.Charge(context.Background(), &ChargeRequest{
+		Email:       String("bojack@horsinaround.com"),
+		Amount:      NewMoney(10000, GHS),
+		MobileMoney: &MobileMoney{Phone: String("0551234987"), Provider: MobileMoneyVodafone},
+	})
+	if err != nil {
+		t.Fatalf("Charge.Charge returned error: %v", err)
+	}
+	if tr.NextAction() != ChargeSendOTP || tr.GetDisplayText() == "" {
+		t.Errorf("Charge.Charge returned %+v, want a charge waiting for the OTP", tr)
+	}
+}
+
+func TestChargeRequest_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(&ChargeRequest{
+		Email: String("bojack@horsinaround.com"),
+		Bank:  Bank{Code: String("057"), AccountNumber: String("0000000000")},
+	})
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	var body map[string]interface{}
+	json.Unmarshal(b, &body)
+	if _, ok := body["card"]; ok {
+		t.Errorf("ChargeRequest encoded an unset card: %s", b)
+	}
+	want := map[string]interface{}{"code": "057", "account_number": "0000000000"}
+	if !cmp.Equal(body["bank"], want) {
+		t.Errorf("ChargeRequest encoded bank %v, want %v", body["bank"], want)
+	}
+}
+
+func TestChargeService_submit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var paths []string
+	mux.HandleFunc("/charge/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["reference"] != "ref-1" {
+			t.Errorf("%s request reference = %v, want ref-1", r.URL.Path, body["reference"])
+		}
+		paths = append(paths, r.URL.Path)
+		fmt.Fprint(w, `{"status": true, "message": "Charge attempted", "data": {"reference": "ref-1", "status": "success"}}`)
+	})
+
+	ctx := context.Background()
+	ref := String("ref-1")
+	client.Charge.SubmitPIN(ctx, &PinRequest{Pin: String("1234"), Reference: ref})
+	client.Charge.SubmitOTP(ctx, &OTPRequest{OTP: String("123456"), Reference: ref})
+	client.Charge.SubmitPhone(ctx, &PhoneRequest{Phone: String("08012345678"), Reference: ref})
+	client.Charge.SubmitBirthday(ctx, &BirthdayRequest{Birthday: String("1961-09-21"), Reference: ref})
+	client.Charge.SubmitAddress(ctx, &AddressRequest{Address: String("140 N 2ND ST"), City: String("Stroudsburg"),
+		State: String("PA"), ZipCode: String("18360"), Reference: ref})
+
+	want := []string{
+		"/charge/submit_pin",
+		"/charge/submit_otp",
+		"/charge/submit_phone",
+		"/charge/submit_birthday",
+		"/charge/submit_address",
+	}
+	if !cmp.Equal(paths, want) {
+		t.Errorf("Charge submissions were sent to %v, want %v", paths, want)
+	}
+}
+
+func TestChargeService_CheckPending(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/charge/ref-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Reference check successful",
+		  "data": {
+			"reference": "ref-1",
+			"status": "pending_bank_transfer",
+			"display_text": "Please make a transfer to the account specified",
+			"account_name": "PAYSTACK CHECKOUT",
+			"account_number": "9930000737",
+			"bank": {"slug": "test-bank", "name": "Test Bank", "id": 24},
+			"account_expires_at": "2023-09-12T13:10:00.000Z"
+		  }
+		}`)
+	})
+
+	tr, _, err := client.Charge.CheckPending(context.Background(), String("ref-1"))
+	if err != nil {
+		t.Fatalf("Charge.CheckPending returned error: %v", err)
+	}
+	if tr.NextAction() != ChargePayOffline || tr.GetAccountNumber() != "9930000737" || tr.Bank.GetName() != "Test Bank" {
+		t.Errorf("Charge.CheckPending returned %+v, want a pending bank transfer", tr)
+	}
+}
+
+func TestTransaction_NextAction(t *testing.T) {
+	tests := map[string]ChargeAction{
+		"send_pin":      ChargeSendPIN,
+		"send_otp":      ChargeSendOTP,
+		"send_phone":    ChargeSendPhone,
+		"send_birthday": ChargeSendBirthday,
+		"send_address":  ChargeSendAddress,
+		"open_url":      ChargeOpenURL,
+		"pay_offline":   ChargePayOffline,
+		"success":       0,
+		"pending":       0,
+	}
+	for status, want := range tests {
+		if got := (&Transaction{Status: String(status)}).NextAction(); got != want {
+			t.Errorf("NextAction of a %s charge = %v, want %v", status, got, want)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"time"
 )
 
@@ -52,6 +53,15 @@ type Customer struct {
 	Authorizations []Authorization `json:"authorizations,omitempty"`
 }
 
+// UnmarshalJSON also accepts the customer id or code, which some endpoints
+// return in place of the customer object.
+func (c *Customer) UnmarshalJSON(data []byte) error {
+	type customer Customer
+	return unmarshalRef(data, (*customer)(c),
+		func(id int) { c.Id = &id },
+		func(code string) { c.CustomerCode = &code })
+}
+
 type CustomerRequest struct {
 	Email     *string  `json:"email, omitempty"`
 	FirstName *string  `json:"first_name,omitempty"`
@@ -70,15 +80,12 @@ func (s *CustomerService) Create(ctx context.Context, cr *CustomerRequest) (*Cus
 	if err != nil {
 		//return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Customer])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-
-	c := new(Customer)
-	mapDecoder(r.Data, c)
-	return c, resp, nil
+	return &r.Data, resp, nil
 }
 
 // List returns an array all customers
@@ -97,18 +104,25 @@ func (s *CustomerService) List(ctx context.Context, opt *ListOptions) ([]*Custom
 		return nil, nil, err
 	}
 
-	lr := new(StandardListResponse)
+	lr := new(Envelope[[]*Customer])
 	resp, err := s.client.Do(ctx, req, lr)
 	if err != nil {
 		return nil, resp, err
 	}
-	var ca []*Customer
-	for _, x := range lr.Data {
-		c := new(Customer)
-		mapDecoder(x, c)
-		ca = append(ca, c)
-	}
-	return ca, resp, nil
+	return lr.Data, resp, nil
+}
+
+// ListAll iterates over all customers, calling List for each page
+func (s *CustomerService) ListAll(ctx context.Context, opt *ListOptions, iopt *IterOptions) iter.Seq2[*Customer, error] {
+	var o ListOptions
+	if opt != nil {
+		o = *opt
+	}
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]*Customer, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
 }
 
 // Fetch returns a new customer with the id
@@ -122,17 +136,12 @@ func (s *CustomerService) Fetch(ctx context.Context, id string, options *Custome
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Customer])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	var c Customer
-	err = mapDecoder(r.Data, &c)
-	if err != nil {
-		return nil, resp, err
-	}
-	return &c, resp, nil
+	return &r.Data, resp, nil
 }
 
 // Update updates a customer model
@@ -145,14 +154,12 @@ func (s *CustomerService) Update(ctx context.Context, cr *CustomerRequest, custo
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Customer])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	var c Customer
-	mapDecoder(r.Data, &c)
-	return &c, resp, nil
+	return &r.Data, resp, nil
 }
 
 //SetRiskAction takes a risk action on a customer
@@ -169,14 +176,12 @@ func (s *CustomerService) SetRiskAction(ctx context.Context, rap *RiskActionPayl
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Customer])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	var c Customer
-	mapDecoder(r.Data, &c)
-	return &c, resp, nil
+	return &r.Data, resp, nil
 }
 
 //DeactivateAuthorization forgets a customer's card
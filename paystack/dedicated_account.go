@@ -0,0 +1,270 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// DedicatedAccountService handles the communication with the Dedicated Virtual Accounts related parts of the Paystack API
+type DedicatedAccountService service
+
+type DedicatedAccount struct {
+	Id            *int                        `json:"id,omitempty"`
+	Bank          DedicatedAccountBank        `json:"bank,omitempty"`
+	AccountName   *string                     `json:"account_name,omitempty"`
+	AccountNumber *string                     `json:"account_number,omitempty"`
+	Assigned      *bool                       `json:"assigned,omitempty"`
+	Currency      *Currency                   `json:"currency,omitempty"`
+	Metadata      MetadataMap                 `json:"metadata,omitempty"`
+	Active        *bool                       `json:"active,omitempty"`
+	SplitConfig   MetadataMap                 `json:"split_config,omitempty"`
+	Assignment    *DedicatedAccountAssignment `json:"assignment,omitempty"`
+	Customer      Customer                    `json:"customer,omitempty"`
+	CreatedAt     *time.Time                  `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time                  `json:"updated_at,omitempty"`
+}
+
+type DedicatedAccountBank struct {
+	Id   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Slug *string `json:"slug,omitempty"`
+}
+
+// DedicatedAccountAssignment describes whom a dedicated account is assigned to
+type DedicatedAccountAssignment struct {
+	Integration  *int       `json:"integration,omitempty"`
+	AssigneeId   *int       `json:"assignee_id,omitempty"`
+	AssigneeType *string    `json:"assignee_type,omitempty"`
+	Expired      *bool      `json:"expired,omitempty"`
+	AccountType  *string    `json:"account_type,omitempty"`
+	AssignedAt   *time.Time `json:"assigned_at,omitempty"`
+	ExpiredAt    *time.Time `json:"expired_at,omitempty"`
+}
+
+// DedicatedAccountProvider is a bank that can issue dedicated accounts
+type DedicatedAccountProvider struct {
+	Id           *int    `json:"id,omitempty"`
+	ProviderSlug *string `json:"provider_slug,omitempty"`
+	BankId       *int    `json:"bank_id,omitempty"`
+	BankName     *string `json:"bank_name,omitempty"`
+}
+
+// DedicatedAccountRequest creates a dedicated account for a customer.
+// Customer is the customer id or code, and PreferredBank the provider slug
+// returned by Providers.
+type DedicatedAccountRequest struct {
+	Customer      *string `json:"customer,omitempty"`
+	PreferredBank *string `json:"preferred_bank,omitempty"`
+	Subaccount    *string `json:"subaccount,omitempty"`
+	SplitCode     *string `json:"split_code,omitempty"`
+	FirstName     *string `json:"first_name,omitempty"`
+	LastName      *string `json:"last_name,omitempty"`
+	Phone         *string `json:"phone,omitempty"`
+}
+
+// DedicatedAccountSplitRequest splits the payments received on a customer's
+// dedicated account with a subaccount or split
+type DedicatedAccountSplitRequest struct {
+	Customer      *string `json:"customer,omitempty"`
+	Subaccount    *string `json:"subaccount,omitempty"`
+	SplitCode     *string `json:"split_code,omitempty"`
+	PreferredBank *string `json:"preferred_bank,omitempty"`
+}
+
+// DedicatedAccountOptions specifies the optional parameters to
+// DedicatedAccountService.List
+type DedicatedAccountOptions struct {
+	ListOptions
+
+	// Active filters accounts by whether they are active.
+	Active *bool `url:"active,omitempty"`
+
+	// Currency filters accounts by currency.
+	Currency Currency `url:"currency,omitempty"`
+
+	// ProviderSlug filters accounts by the bank that issued them.
+	ProviderSlug string `url:"provider_slug,omitempty"`
+
+	// BankId filters accounts by the id of the bank that issued them.
+	BankId string `url:"bank_id,omitempty"`
+
+	// Customer filters accounts by customer id.
+	Customer string `url:"customer,omitempty"`
+}
+
+// DedicatedAccountRequeryOptions specifies the account to check for new
+// transfers in DedicatedAccountService.Requery. Date is in the YYYY-MM-DD
+// format.
+type DedicatedAccountRequeryOptions struct {
+	AccountNumber string `url:"account_number"`
+	ProviderSlug  string `url:"provider_slug"`
+	Date          string `url:"date,omitempty"`
+}
+
+// Create creates a dedicated account for a customer
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#create-dedicated-virtual-account
+func (s *DedicatedAccountService) Create(ctx context.Context, dr *DedicatedAccountRequest) (*DedicatedAccount, *Response, error) {
+	u := fmt.Sprintf("dedicated_account")
+	req, err := s.client.NewRequest("POST", u, dr)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[DedicatedAccount])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// List returns the dedicated accounts available on the integration
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#list-dedicated-accounts
+func (s *DedicatedAccountService) List(ctx context.Context, opt *DedicatedAccountOptions) ([]DedicatedAccount, *Response, error) {
+	u := fmt.Sprintf("dedicated_account")
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	lr := new(Envelope[[]DedicatedAccount])
+	resp, err := s.client.Do(ctx, req, lr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lr.Data, resp, nil
+}
+
+// ListAll iterates over all dedicated accounts, calling List for each page
+func (s *DedicatedAccountService) ListAll(ctx context.Context, opt *DedicatedAccountOptions, iopt *IterOptions) iter.Seq2[DedicatedAccount, error] {
+	var o DedicatedAccountOptions
+	if opt != nil {
+		o = *opt
+	}
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]DedicatedAccount, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
+}
+
+// Fetch returns the details of a dedicated account
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#fetch-dedicated-account
+func (s *DedicatedAccountService) Fetch(ctx context.Context, id string) (*DedicatedAccount, *Response, error) {
+	u := fmt.Sprintf("dedicated_account/" + id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[DedicatedAccount])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// Deactivate deactivates a dedicated account
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#deactivate-dedicated-account
+func (s *DedicatedAccountService) Deactivate(ctx context.Context, id string) (*DedicatedAccount, *Response, error) {
+	u := fmt.Sprintf("dedicated_account/" + id)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[DedicatedAccount])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// Requery asks Paystack to check a dedicated account for transfers that
+// have not been notified yet
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#requery-dedicated-account
+func (s *DedicatedAccountService) Requery(ctx context.Context, opt *DedicatedAccountRequeryOptions) (*Response, error) {
+	u := fmt.Sprintf("dedicated_account/requery")
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// Split splits the payments received on a customer's dedicated account,
+// creating the account if the customer has none
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#split-dedicated-account-transaction
+func (s *DedicatedAccountService) Split(ctx context.Context, sr *DedicatedAccountSplitRequest) (*DedicatedAccount, *Response, error) {
+	u := fmt.Sprintf("dedicated_account/split")
+	req, err := s.client.NewRequest("POST", u, sr)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[DedicatedAccount])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// RemoveSplit stops splitting the payments received on a dedicated account,
+// given its account number
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#remove-split-from-dedicated-account
+func (s *DedicatedAccountService) RemoveSplit(ctx context.Context, accountNumber string) (*DedicatedAccount, *Response, error) {
+	u := fmt.Sprintf("dedicated_account/split")
+	body := struct {
+		AccountNumber string `json:"account_number"`
+	}{accountNumber}
+	req, err := s.client.NewRequest("DELETE", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[DedicatedAccount])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// Providers returns the banks that can issue dedicated accounts
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#fetch-bank-providers
+func (s *DedicatedAccountService) Providers(ctx context.Context) ([]DedicatedAccountProvider, *Response, error) {
+	u := fmt.Sprintf("dedicated_account/available_providers")
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	lr := new(Envelope[[]DedicatedAccountProvider])
+	resp, err := s.client.Do(ctx, req, lr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lr.Data, resp, nil
+}
@@ -0,0 +1,159 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDedicatedAccountService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/dedicated_account", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if want := map[string]interface{}{"customer": "CUS_358xertt55", "preferred_bank": "test-bank"}; !cmp.Equal(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "NUBAN successfully created",
+		  "data": {
+			"bank": {"name": "Test Bank", "id": 20, "slug": "test-bank"},
+			"account_name": "KaroKart Rhoda Church",
+			"account_number": "9930000737",
+			"assigned": true,
+			"currency": "NGN",
+			"metadata": null,
+			"active": true,
+			"id": 253,
+			"created_at": "2019-12-12T12:39:04.000Z",
+			"updated_at": "2020-01-06T15:51:24.000Z",
+			"assignment": {
+			  "integration": 100043,
+			  "assignee_id": 7454289,
+			  "assignee_type": "Customer",
+			  "expired": false,
+			  "account_type": "PAY-WITH-TRANSFER-RECURRING",
+			  "assigned_at": "2020-01-06T15:51:24.764Z"
+			},
+			"customer": {"id": 7454289, "email": "rhoda@example.com", "customer_code": "CUS_358xertt55"}
+		  }
+		}`)
+	})
+
+	account, _, err := client.DedicatedAccount.Create(context.Background(), &DedicatedAccountRequest{
+		Customer:      String("CUS_358xertt55"),
+		PreferredBank: String("test-bank"),
+	})
+	if err != nil {
+		t.Errorf("DedicatedAccount.Create returned error: %v", err)
+	}
+
+	created := time.Date(2019, 12, 12, 12, 39, 4, 0, time.UTC)
+	updated := time.Date(2020, 1, 6, 15, 51, 24, 0, time.UTC)
+	assigned := time.Date(2020, 1, 6, 15, 51, 24, 764000000, time.UTC)
+	want := &DedicatedAccount{
+		Bank:          DedicatedAccountBank{Name: String("Test Bank"), Id: Int(20), Slug: String("test-bank")},
+		AccountName:   String("KaroKart Rhoda Church"),
+		AccountNumber: String("9930000737"),
+		Assigned:      Bool(true),
+		Currency:      NGN.Ptr(),
+		Active:        Bool(true),
+		Id:            Int(253),
+		CreatedAt:     &created,
+		UpdatedAt:     &updated,
+		Assignment: &DedicatedAccountAssignment{
+			Integration:  Int(100043),
+			AssigneeId:   Int(7454289),
+			AssigneeType: String("Customer"),
+			Expired:      Bool(false),
+			AccountType:  String("PAY-WITH-TRANSFER-RECURRING"),
+			AssignedAt:   &assigned,
+		},
+		Customer: Customer{Id: Int(7454289), Email: String("rhoda@example.com"), CustomerCode: String("CUS_358xertt55")},
+	}
+	if !cmp.Equal(account, want) {
+		t.Errorf("DedicatedAccount.Create returned %+v, want %+v", account, want)
+	}
+}
+
+func TestDedicatedAccountService_Requery(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/dedicated_account/requery", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"account_number": "1234567890", "provider_slug": "wema-bank", "date": "2023-05-30"})
+		fmt.Fprint(w, `{"status": true, "message": "We are checking the status of your transfer. We will send you a notification once it is confirmed"}`)
+	})
+
+	_, err := client.DedicatedAccount.Requery(context.Background(), &DedicatedAccountRequeryOptions{
+		AccountNumber: "1234567890",
+		ProviderSlug:  "wema-bank",
+		Date:          "2023-05-30",
+	})
+	if err != nil {
+		t.Errorf("DedicatedAccount.Requery returned error: %v", err)
+	}
+}
+
+func TestDedicatedAccountService_RemoveSplit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/dedicated_account/split", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if want := map[string]interface{}{"account_number": "0033322211"}; !cmp.Equal(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Subaccount unassigned",
+		  "data": {"id": 22495, "account_number": "0033322211", "split_config": {}}
+		}`)
+	})
+
+	account, _, err := client.DedicatedAccount.RemoveSplit(context.Background(), "0033322211")
+	if err != nil {
+		t.Errorf("DedicatedAccount.RemoveSplit returned error: %v", err)
+	}
+
+	want := &DedicatedAccount{Id: Int(22495), AccountNumber: String("0033322211"), SplitConfig: MetadataMap{}}
+	if !cmp.Equal(account, want) {
+		t.Errorf("DedicatedAccount.RemoveSplit returned %+v, want %+v", account, want)
+	}
+}
+
+func TestDedicatedAccountService_Providers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/dedicated_account/available_providers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Dedicated account providers retrieved",
+		  "data": [{"provider_slug": "wema-bank", "bank_id": 20, "bank_name": "Wema Bank", "id": 5}]
+		}`)
+	})
+
+	providers, _, err := client.DedicatedAccount.Providers(context.Background())
+	if err != nil {
+		t.Errorf("DedicatedAccount.Providers returned error: %v", err)
+	}
+
+	want := []DedicatedAccountProvider{{ProviderSlug: String("wema-bank"), BankId: Int(20), BankName: String("Wema Bank"), Id: Int(5)}}
+	if !cmp.Equal(providers, want) {
+		t.Errorf("DedicatedAccount.Providers returned %+v, want %+v", providers, want)
+	}
+}
@@ -0,0 +1,357 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// DisputeService handles the communication with the Disputes related parts of the Paystack API
+type DisputeService service
+
+// DisputeStatus enumerates the states a dispute goes through
+type DisputeStatus int
+
+const (
+	DisputeAwaitingMerchantFeedback DisputeStatus = 1 + iota
+	DisputeAwaitingBankFeedback
+	DisputePending
+	DisputeResolved
+	DisputeArchived
+)
+
+var disputeStatuses = [...]string{
+	"awaiting-merchant-feedback",
+	"awaiting-bank-feedback",
+	"pending",
+	"resolved",
+	"archived",
+}
+
+// String returns the API name of a DisputeStatus, or "" if it is unset
+func (d DisputeStatus) String() string { return enumString(disputeStatuses[:], int(d)) }
+
+func (d DisputeStatus) MarshalText() ([]byte, error) { return []byte(d.String()), nil }
+
+func (d *DisputeStatus) UnmarshalText(text []byte) error {
+	v, err := enumParseStrict(disputeStatuses[:], "dispute status", string(text))
+	*d = DisputeStatus(v)
+	return err
+}
+
+// DisputeResolution enumerates the outcomes of a resolved dispute
+type DisputeResolution int
+
+const (
+	DisputeMerchantAccepted DisputeResolution = 1 + iota
+	DisputeDeclined
+)
+
+var disputeResolutions = [...]string{
+	"merchant-accepted",
+	"declined",
+}
+
+// String returns the API name of a DisputeResolution, or "" if it is unset
+func (d DisputeResolution) String() string { return enumString(disputeResolutions[:], int(d)) }
+
+func (d DisputeResolution) MarshalText() ([]byte, error) { return []byte(d.String()), nil }
+
+func (d *DisputeResolution) UnmarshalText(text []byte) error {
+	v, err := enumParseStrict(disputeResolutions[:], "dispute resolution", string(text))
+	*d = DisputeResolution(v)
+	return err
+}
+
+// DisputeCategory enumerates the kinds of dispute
+type DisputeCategory int
+
+const (
+	DisputeChargeback DisputeCategory = 1 + iota
+	DisputeFraud
+)
+
+var disputeCategories = [...]string{
+	"chargeback",
+	"fraud",
+}
+
+// String returns the API name of a DisputeCategory, or "" if it is unset
+func (d DisputeCategory) String() string { return enumString(disputeCategories[:], int(d)) }
+
+func (d DisputeCategory) MarshalText() ([]byte, error) { return []byte(d.String()), nil }
+
+func (d *DisputeCategory) UnmarshalText(text []byte) error {
+	v, err := enumParseStrict(disputeCategories[:], "dispute category", string(text))
+	*d = DisputeCategory(v)
+	return err
+}
+
+type Dispute struct {
+	Id                   *int              `json:"id,omitempty"`
+	RefundAmount         *Money            `json:"refund_amount,omitempty"`
+	Currency             *Currency         `json:"currency,omitempty"`
+	Status               DisputeStatus     `json:"status,omitempty"`
+	Resolution           DisputeResolution `json:"resolution,omitempty"`
+	Category             DisputeCategory   `json:"category,omitempty"`
+	Domain               *string           `json:"domain,omitempty"`
+	Transaction          Transaction       `json:"transaction,omitempty"`
+	TransactionReference *string           `json:"transaction_reference,omitempty"`
+	Customer             Customer          `json:"customer,omitempty"`
+	Bin                  *string           `json:"bin,omitempty"`
+	Last4                *string           `json:"last4,omitempty"`
+	Evidence             *DisputeEvidence  `json:"evidence,omitempty"`
+	Attachments          *string           `json:"attachments,omitempty"`
+	Note                 *string           `json:"note,omitempty"`
+	History              []DisputeHistory  `json:"history,omitempty"`
+	Messages             []DisputeMessage  `json:"messages,omitempty"`
+	DueAt                *time.Time        `json:"dueAt,omitempty"`
+	ResolvedAt           *time.Time        `json:"resolvedAt,omitempty"`
+	CreatedAt            *time.Time        `json:"createdAt,omitempty"`
+	UpdatedAt            *time.Time        `json:"updatedAt,omitempty"`
+}
+
+type DisputeHistory struct {
+	Status    DisputeStatus `json:"status,omitempty"`
+	By        *string       `json:"by,omitempty"`
+	CreatedAt *time.Time    `json:"createdAt,omitempty"`
+}
+
+type DisputeMessage struct {
+	Sender    *string    `json:"sender,omitempty"`
+	Body      *string    `json:"body,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+}
+
+type DisputeEvidence struct {
+	CustomerEmail   *string    `json:"customer_email,omitempty"`
+	CustomerName    *string    `json:"customer_name,omitempty"`
+	CustomerPhone   *string    `json:"customer_phone,omitempty"`
+	ServiceDetails  *string    `json:"service_details,omitempty"`
+	DeliveryAddress *string    `json:"delivery_address,omitempty"`
+	DeliveryDate    *string    `json:"delivery_date,omitempty"`
+	Dispute         *int       `json:"dispute,omitempty"`
+	Id              *int       `json:"id,omitempty"`
+	CreatedAt       *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt       *time.Time `json:"updatedAt,omitempty"`
+}
+
+// DisputeEvidenceRequest adds evidence to a dispute. DeliveryDate is in the
+// YYYY-MM-DD format.
+type DisputeEvidenceRequest struct {
+	CustomerEmail   *string `json:"customer_email,omitempty"`
+	CustomerName    *string `json:"customer_name,omitempty"`
+	CustomerPhone   *string `json:"customer_phone,omitempty"`
+	ServiceDetails  *string `json:"service_details,omitempty"`
+	DeliveryAddress *string `json:"delivery_address,omitempty"`
+	DeliveryDate    *string `json:"delivery_date,omitempty"`
+}
+
+type DisputeUpdateRequest struct {
+	RefundAmount     *Money  `json:"refund_amount,omitempty"`
+	UploadedFilename *string `json:"uploaded_filename,omitempty"`
+}
+
+// DisputeResolveRequest resolves a dispute. Evidence is the id of the
+// evidence returned by AddEvidence, and is required when declining.
+type DisputeResolveRequest struct {
+	Resolution       DisputeResolution `json:"resolution"`
+	Message          *string           `json:"message,omitempty"`
+	RefundAmount     *Money            `json:"refund_amount,omitempty"`
+	UploadedFilename *string           `json:"uploaded_filename,omitempty"`
+	Evidence         *int              `json:"evidence,omitempty"`
+}
+
+type DisputeUploadURL struct {
+	SignedUrl *string `json:"signedUrl,omitempty"`
+	FileName  *string `json:"fileName,omitempty"`
+}
+
+// DisputeOptions specifies the optional parameters to DisputeService.List
+// and DisputeService.Export
+type DisputeOptions struct {
+	ListOptions
+
+	// From and To limit disputes to those created in the range.
+	From time.Time `url:"from,omitempty"`
+	To   time.Time `url:"to,omitempty"`
+
+	// Transaction filters disputes by transaction id.
+	Transaction string `url:"transaction,omitempty"`
+
+	// Status filters disputes by status.
+	Status DisputeStatus `url:"status,omitempty"`
+}
+
+// List returns the disputes filed against the integration
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#list-disputes
+func (s *DisputeService) List(ctx context.Context, opt *DisputeOptions) ([]Dispute, *Response, error) {
+	u := fmt.Sprintf("dispute")
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	lr := new(Envelope[[]Dispute])
+	resp, err := s.client.Do(ctx, req, lr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lr.Data, resp, nil
+}
+
+// ListAll iterates over all disputes, calling List for each page
+func (s *DisputeService) ListAll(ctx context.Context, opt *DisputeOptions, iopt *IterOptions) iter.Seq2[Dispute, error] {
+	var o DisputeOptions
+	if opt != nil {
+		o = *opt
+	}
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]Dispute, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
+}
+
+// Fetch returns the details of a dispute
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#fetch-dispute
+func (s *DisputeService) Fetch(ctx context.Context, id string) (*Dispute, *Response, error) {
+	u := fmt.Sprintf("dispute/" + id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Dispute])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// ListTransactionDisputes returns the dispute filed against a transaction
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#list-transaction-disputes
+func (s *DisputeService) ListTransactionDisputes(ctx context.Context, transactionID string) (*Dispute, *Response, error) {
+	u := fmt.Sprintf("dispute/transaction/" + transactionID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Dispute])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// Update updates the refund amount or attachment of a dispute
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#update-dispute
+func (s *DisputeService) Update(ctx context.Context, id string, dr *DisputeUpdateRequest) (*Dispute, *Response, error) {
+	u := fmt.Sprintf("dispute/" + id)
+	req, err := s.client.NewRequest("PUT", u, dr)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Dispute])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// AddEvidence provides evidence for a dispute
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#add-evidence
+func (s *DisputeService) AddEvidence(ctx context.Context, id string, er *DisputeEvidenceRequest) (*DisputeEvidence, *Response, error) {
+	u := fmt.Sprintf("dispute/" + id + "/evidence")
+	req, err := s.client.NewRequest("POST", u, er)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[DisputeEvidence])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// UploadURL returns a signed URL to upload a file to attach to a dispute
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#get-upload-url
+func (s *DisputeService) UploadURL(ctx context.Context, id string, filename string) (*DisputeUploadURL, *Response, error) {
+	u := fmt.Sprintf("dispute/" + id + "/upload_url")
+	u, err := addOptions(u, &struct {
+		UploadFilename string `url:"upload_filename"`
+	}{filename})
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[DisputeUploadURL])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// Resolve resolves a dispute, either accepting it or declining it with
+// evidence
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#resolve-dispute
+func (s *DisputeService) Resolve(ctx context.Context, id string, rr *DisputeResolveRequest) (*Dispute, *Response, error) {
+	u := fmt.Sprintf("dispute/" + id + "/resolve")
+	req, err := s.client.NewRequest("PUT", u, rr)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Dispute])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// Export returns a link to a CSV export of the disputes matching opt
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#export-disputes
+func (s *DisputeService) Export(ctx context.Context, opt *DisputeOptions) (*ExportPath, *Response, error) {
+	u := fmt.Sprintf("dispute/export")
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[ExportPath])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
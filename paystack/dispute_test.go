@@ -0,0 +1,157 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDisputeService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/dispute", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"from":   "2017-01-01T00:00:00Z",
+			"status": "awaiting-merchant-feedback",
+		})
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Disputes retrieved",
+		  "data": [
+			{
+			  "id": 2867,
+			  "refund_amount": null,
+			  "currency": "NGN",
+			  "status": "awaiting-merchant-feedback",
+			  "resolution": null,
+			  "category": "chargeback",
+			  "domain": "test",
+			  "transaction": {"id": 5991760, "reference": "asfoeu9afae"},
+			  "transaction_reference": null,
+			  "history": [{"status": "awaiting-merchant-feedback", "by": "demo@test.co"}],
+			  "messages": [{"sender": "demo@test.co", "body": "Customer claims never received goods"}]
+			}
+		  ],
+		  "meta": {"total": 1, "skipped": 0, "perPage": 50, "page": 1, "pageCount": 1}
+		}`)
+	})
+
+	from := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	disputes, _, err := client.Dispute.List(context.Background(), &DisputeOptions{From: from, Status: DisputeAwaitingMerchantFeedback})
+	if err != nil {
+		t.Errorf("Dispute.List returned error: %v", err)
+	}
+
+	want := []Dispute{{
+		Id:          Int(2867),
+		Currency:    NGN.Ptr(),
+		Status:      DisputeAwaitingMerchantFeedback,
+		Category:    DisputeChargeback,
+		Domain:      String("test"),
+		Transaction: Transaction{Id: Int(5991760), Reference: String("asfoeu9afae")},
+		History:     []DisputeHistory{{Status: DisputeAwaitingMerchantFeedback, By: String("demo@test.co")}},
+		Messages:    []DisputeMessage{{Sender: String("demo@test.co"), Body: String("Customer claims never received goods")}},
+	}}
+	if !cmp.Equal(disputes, want) {
+		t.Errorf("Dispute.List returned %+v, want %+v", disputes, want)
+	}
+}
+
+func TestDisputeService_Fetch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/dispute/2867", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Dispute retrieved",
+		  "data": {"id": 2867, "status": "resolved", "resolution": "merchant-accepted", "transaction": 5991760}
+		}`)
+	})
+
+	dispute, _, err := client.Dispute.Fetch(context.Background(), "2867")
+	if err != nil {
+		t.Errorf("Dispute.Fetch returned error: %v", err)
+	}
+
+	want := &Dispute{Id: Int(2867), Status: DisputeResolved, Resolution: DisputeMerchantAccepted, Transaction: Transaction{Id: Int(5991760)}}
+	if !cmp.Equal(dispute, want) {
+		t.Errorf("Dispute.Fetch returned %+v, want %+v", dispute, want)
+	}
+}
+
+func TestDisputeService_Resolve(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/dispute/2867/resolve", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		want := map[string]interface{}{"resolution": "declined", "message": "Goods were delivered", "evidence": float64(21)}
+		if !cmp.Equal(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Dispute successfully resolved",
+		  "data": {"id": 2867, "status": "resolved", "resolution": "declined"}
+		}`)
+	})
+
+	dispute, _, err := client.Dispute.Resolve(context.Background(), "2867", &DisputeResolveRequest{
+		Resolution: DisputeDeclined,
+		Message:    String("Goods were delivered"),
+		Evidence:   Int(21),
+	})
+	if err != nil {
+		t.Errorf("Dispute.Resolve returned error: %v", err)
+	}
+
+	want := &Dispute{Id: Int(2867), Status: DisputeResolved, Resolution: DisputeDeclined}
+	if !cmp.Equal(dispute, want) {
+		t.Errorf("Dispute.Resolve returned %+v, want %+v", dispute, want)
+	}
+}
+
+func TestDisputeService_UploadURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/dispute/2867/upload_url", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"upload_filename": "receipt.pdf"})
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Upload url generated",
+		  "data": {"signedUrl": "https://files.paystack.co/disputes/receipt.pdf?sig=abc", "fileName": "receipt.pdf"}
+		}`)
+	})
+
+	upload, _, err := client.Dispute.UploadURL(context.Background(), "2867", "receipt.pdf")
+	if err != nil {
+		t.Errorf("Dispute.UploadURL returned error: %v", err)
+	}
+
+	want := &DisputeUploadURL{SignedUrl: String("https://files.paystack.co/disputes/receipt.pdf?sig=abc"), FileName: String("receipt.pdf")}
+	if !cmp.Equal(upload, want) {
+		t.Errorf("Dispute.UploadURL returned %+v, want %+v", upload, want)
+	}
+}
+
+func TestDisputeStatus_unknown(t *testing.T) {
+	var d Dispute
+	err := json.Unmarshal([]byte(`{"id": 1, "status": "escalated"}`), &d)
+	if err == nil || !strings.Contains(err.Error(), `unknown dispute status "escalated"`) {
+		t.Errorf("json.Unmarshal of an unknown dispute status returned %v", err)
+	}
+}
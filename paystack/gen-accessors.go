@@ -188,6 +188,10 @@ func (t *templateData) addIdent(x *ast.Ident, receiverType, fieldName string) {
 		zeroValue = "false"
 	case "Timestamp":
 		zeroValue = "Timestamp{}"
+	case "Money":
+		zeroValue = "Money{}"
+	case "Currency":
+		zeroValue = `""`
 	default: // other structs handled by their receivers directly.
 		return
 	}
@@ -21,14 +21,12 @@ func (s *IntegrationService) FetchPaymentSessionTimeout(ctx context.Context) (*P
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[PaymentSession])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(PaymentSession)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
 
 //UpdatePaymentSessionTimeout
@@ -42,12 +40,10 @@ func (s *IntegrationService) UpdatePaymentSessionTimeout(ctx context.Context, op
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[PaymentSession])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(PaymentSession)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
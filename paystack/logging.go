@@ -0,0 +1,156 @@
+package paystack
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// maxLoggedBody is the number of bytes of a body logged by Logging.
+const maxLoggedBody = 4096
+
+// LogOptions configures the Logging middleware.
+type LogOptions struct {
+	// Level is the level requests are logged at, slog.LevelInfo by
+	// default. Failed requests are logged at slog.LevelWarn or above.
+	Level slog.Level
+
+	// Bodies logs the request and response bodies, up to 4KB each, after
+	// redacting secret keys, card numbers, CVVs, PINs, OTPs, BVNs and
+	// authorization codes.
+	Bodies bool
+}
+
+// Logging returns a Middleware that logs every request sent to Paystack
+// and its outcome to l, with the operation, method, path, status, duration
+// and request ID. The Authorization header is never logged. opt may be nil.
+func Logging(l *slog.Logger, opt *LogOptions) Middleware {
+	var o LogOptions
+	if opt != nil {
+		o = *opt
+	}
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			if !l.Enabled(ctx, o.Level) && !l.Enabled(ctx, slog.LevelWarn) {
+				return next.RoundTrip(req)
+			}
+			attrs := []slog.Attr{
+				slog.String("operation", Operation(ctx)),
+				slog.String("method", req.Method),
+				slog.String("path", Redact(req.URL.Path)),
+			}
+			if req.URL.RawQuery != "" {
+				attrs = append(attrs, slog.String("query", Redact(req.URL.RawQuery)))
+			}
+			if o.Bodies && req.GetBody != nil {
+				if body, err := req.GetBody(); err == nil {
+					attrs = append(attrs, slog.String("request_body", readLogged(body)))
+				}
+			}
+
+			start := time.Now()
+			resp, err := next.RoundTrip(req)
+			attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+
+			level := o.Level
+			switch {
+			case err != nil:
+				level = max(level, slog.LevelError)
+				attrs = append(attrs, slog.String("error", Redact(err.Error())))
+			default:
+				if resp.StatusCode >= 400 {
+					level = max(level, slog.LevelWarn)
+				}
+				attrs = append(attrs, slog.Int("status", resp.StatusCode))
+				if id := resp.Header.Get(headerRequestID); id != "" {
+					attrs = append(attrs, slog.String("request_id", id))
+				}
+				if o.Bodies {
+					// Only the logged prefix of the body is read, so that
+					// large downloads still stream to the caller.
+					prefix, rerr := io.ReadAll(io.LimitReader(resp.Body, maxLoggedBody+1))
+					resp.Body = struct {
+						io.Reader
+						io.Closer
+					}{io.MultiReader(bytes.NewReader(prefix), resp.Body), resp.Body}
+					if rerr == nil {
+						attrs = append(attrs, slog.String("response_body", logged(prefix)))
+					}
+				}
+			}
+			l.LogAttrs(ctx, level, "paystack request", attrs...)
+			return resp, err
+		})
+	}
+}
+
+// readLogged reads the whole of body, a copy of a request body held in
+// memory, for logging and closes it.
+func readLogged(body io.ReadCloser) string {
+	defer body.Close()
+	data, _ := io.ReadAll(body)
+	return logged(data)
+}
+
+// logged returns data redacted and truncated for logging. data is redacted
+// before it is truncated, but it may itself be the prefix of a longer body,
+// and a secret cut at its end does not match Redact. The last token of a
+// truncated body is therefore dropped, back to the last comma, brace or
+// newline.
+func logged(data []byte) string {
+	s := Redact(string(data))
+	if len(data) <= maxLoggedBody {
+		return s
+	}
+	s = s[:min(len(s), maxLoggedBody)]
+	s = s[:strings.LastIndexAny(s, ",{}\n")+1]
+	return s + "...(truncated)"
+}
+
+var (
+	secretKeyPattern   = regexp.MustCompile(`\b(sk|pk)_(test|live)_[0-9A-Za-z]+`)
+	cardNumberPattern  = regexp.MustCompile(`\b\d(?:[ -]?\d){11,18}\b`)
+	sensitiveFieldExpr = regexp.MustCompile(`("(?:cvv|pin|otp|bvn|authorization_code|password)"\s*:\s*)("(?:[^"\\]|\\.)*"|\d+)`)
+	sensitiveParamExpr = regexp.MustCompile(`(?i)\b((?:cvv|pin|otp|bvn|authorization_?code|password)=)[^&\s]*`)
+)
+
+// Redact masks the secret keys, card numbers and sensitive JSON fields or
+// query parameters, such as the CVV, PIN, OTP, BVN and authorization code,
+// found in s. Card numbers keep their last four digits.
+func Redact(s string) string {
+	s = secretKeyPattern.ReplaceAllString(s, "${1}_${2}_[REDACTED]")
+	s = sensitiveFieldExpr.ReplaceAllString(s, `${1}"[REDACTED]"`)
+	s = sensitiveParamExpr.ReplaceAllString(s, "${1}[REDACTED]")
+	return cardNumberPattern.ReplaceAllStringFunc(s, func(m string) string {
+		digits := strings.Map(func(r rune) rune {
+			if r < '0' || r > '9' {
+				return -1
+			}
+			return r
+		}, m)
+		if !luhn(digits) {
+			return m
+		}
+		return strings.Repeat("*", len(digits)-4) + digits[len(digits)-4:]
+	})
+}
+
+// luhn reports whether the digits pass the Luhn check used by card numbers.
+func luhn(digits string) bool {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-i)%2 == 0 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
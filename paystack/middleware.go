@@ -0,0 +1,145 @@
+package paystack
+
+import (
+	"context"
+	"net/http"
+	"runtime"
+	"strings"
+)
+
+// A Middleware wraps the transport of the client, to observe or alter each
+// request sent to Paystack and each response received. It is called for
+// every attempt, so a request that is retried passes through it again.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripFunc is an adapter to use a function as an http.RoundTripper in
+// a Middleware.
+type RoundTripFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Use is a referential function that adds middlewares to the client when
+// initializing it. The first middleware is the outermost, seeing requests
+// first and responses last.
+func Use(mw ...Middleware) func(*Client) {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, mw...)
+	}
+}
+
+// OnRequest returns a Middleware that calls f with every request before it
+// is sent, for instance to add headers.
+func OnRequest(f func(*http.Request)) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			f(req)
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// OnResponse returns a Middleware that calls f with every response
+// received, or with the error of a request that got none.
+func OnResponse(f func(*http.Response, error)) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := next.RoundTrip(req)
+			f(resp, err)
+			return resp, err
+		})
+	}
+}
+
+// roundTrip sends req through the middlewares of the client.
+func (c *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	var rt http.RoundTripper = RoundTripFunc(c.client.Do)
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		rt = c.middleware[i](rt)
+	}
+	return rt.RoundTrip(req)
+}
+
+type operationKey struct{}
+
+// Operation returns the service method, such as "Transaction.Verify", that
+// sent the request with ctx, or "" if the request was not made by one. It
+// lets middlewares label requests.
+func Operation(ctx context.Context) string {
+	op, _ := ctx.Value(operationKey{}).(string)
+	return op
+}
+
+// withOperation returns ctx labelled with the service method that called
+// Do, unless it is labelled already.
+func withOperation(ctx context.Context) context.Context {
+	if Operation(ctx) != "" {
+		return ctx
+	}
+	if op := callerOperation(); op != "" {
+		return context.WithValue(ctx, operationKey{}, op)
+	}
+	return ctx
+}
+
+// callerOperation returns the innermost service method on the call stack,
+// as "Transaction.Verify".
+func callerOperation() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		f, more := frames.Next()
+		// Methods are named like
+		// github.com/kehindesalaam/go-paystack/paystack.(*TransferService).Initiate
+		name := f.Function[strings.LastIndex(f.Function, "/")+1:]
+		if rest, ok := strings.CutPrefix(name, "paystack.(*"); ok {
+			if service, method, ok := strings.Cut(rest, "Service)."); ok && isExported(method) {
+				return service + "." + method
+			}
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// isExported reports whether name is an exported identifier, which rules
+// out unexported methods and the closures within methods.
+func isExported(name string) bool {
+	return name != "" && 'A' <= name[0] && name[0] <= 'Z' && !strings.Contains(name, ".")
+}
+
+// An ObserveFunc is called when an API call starts, with the request about
+// to be sent. The context it returns is used for the call, and the function
+// it returns, if not nil, is called when the call ends, after any retries,
+// with its outcome. The Response is nil when no response was received.
+type ObserveFunc func(ctx context.Context, req *http.Request) (context.Context, func(*Response, error))
+
+// Observe is a referential function that adds observers to the client when
+// initializing it. Unlike a Middleware, which sees every attempt, an
+// observer sees every call once, which suits tracing and metrics.
+func Observe(f ...ObserveFunc) func(*Client) {
+	return func(c *Client) {
+		c.observers = append(c.observers, f...)
+	}
+}
+
+// observe starts the observers of the client for a call of req, and returns
+// the context of the call and the function to call when it ends.
+func (c *Client) observe(ctx context.Context, req *http.Request) (context.Context, func(*Response, error)) {
+	var ends []func(*Response, error)
+	for _, f := range c.observers {
+		var end func(*Response, error)
+		if ctx, end = f(ctx, req.WithContext(ctx)); end != nil {
+			ends = append(ends, end)
+		}
+	}
+	return ctx, func(resp *Response, err error) {
+		for i := len(ends) - 1; i >= 0; i-- {
+			ends[i](resp, err)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package paystack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUse_order(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" request")
+				resp, err := next.RoundTrip(req)
+				calls = append(calls, name+" response")
+				return resp, err
+			})
+		}
+	}
+	Use(trace("outer"), trace("inner"))(client)
+
+	mux.HandleFunc("/bank", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "server")
+		fmt.Fprint(w, `{"status": true, "message": "Banks retrieved", "data": []}`)
+	})
+
+	if _, _, err := client.Miscellaneous.ListBanks(context.Background(), nil); err != nil {
+		t.Fatalf("Miscellaneous.ListBanks returned error: %v", err)
+	}
+	want := []string{"outer request", "inner request", "server", "inner response", "outer response"}
+	if strings.Join(calls, ", ") != strings.Join(want, ", ") {
+		t.Errorf("Middlewares were called in order %v, want %v", calls, want)
+	}
+}
+
+func TestOnRequest_OnResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var status int
+	Use(
+		OnRequest(func(r *http.Request) { r.Header.Set("X-Trace-Id", "trace-1") }),
+		OnResponse(func(r *http.Response, err error) { status = r.StatusCode }),
+	)(client)
+
+	mux.HandleFunc("/transaction/verify/T1", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Trace-Id"); got != "trace-1" {
+			t.Errorf("X-Trace-Id header = %q, want trace-1", got)
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status": false, "message": "Transaction reference not found"}`)
+	})
+
+	client.Transaction.Verify(context.Background(), "T1")
+	if status != http.StatusNotFound {
+		t.Errorf("OnResponse saw status %d, want 404", status)
+	}
+}
+
+func TestOperation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var ops []string
+	Use(OnRequest(func(r *http.Request) { ops = append(ops, Operation(r.Context())) }))(client)
+
+	mux.HandleFunc("/transaction/verify/T1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": true, "message": "Verification successful", "data": {"reference": "T1"}}`)
+	})
+	mux.HandleFunc("/transaction/charge_authorization", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": true, "message": "Charge attempted", "data": {"reference": "T2"}}`)
+	})
+
+	ctx := context.Background()
+	client.Transaction.Verify(ctx, "T1")
+	client.Transaction.ChargeAuthorizationOnce(ctx, &TransactionRequest{Reference: String("T2")})
+	req, _ := client.NewRequest("GET", "transaction/verify/T1", nil)
+	client.Do(ctx, req, nil)
+
+	want := []string{"Transaction.Verify", "Transaction.ChargeAuthorization", ""}
+	if strings.Join(ops, ", ") != strings.Join(want, ", ") {
+		t.Errorf("Operation returned %q, want %q", ops, want)
+	}
+}
+
+func TestLogging(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	SecretKey("sk_test_0123456789abcdef")(client)
+	Use(Logging(logger, &LogOptions{Bodies: true}))(client)
+
+	mux.HandleFunc("/charge", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRequestID, "req-1")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"status": false, "message": "Invalid card", "data": {"authorization_code": "AUTH_8dfhjjdt"}}`)
+	})
+
+	req := &ChargeRequest{
+		Email: String("bojack@horsinaround.com"),
+		Card: Card{
+			Number:      String("4084084084084081"),
+			CVV:         String("408"),
+			ExpiryMonth: String("01"),
+			ExpiryYear:  String("99"),
+		},
+	}
+	client.Charge.Charge(context.Background(), req)
+
+	out := buf.String()
+	for _, secret := range []string{"sk_test_0123456789abcdef", "4084084084084081", `"408"`, "AUTH_8dfhjjdt", "Bearer"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Logging logged %q: %s", secret, out)
+		}
+	}
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Logging wrote %q: %v", out, err)
+	}
+	want := map[string]interface{}{
+		"level":      "WARN",
+		"operation":  "Charge.Charge",
+		"method":     "POST",
+		"path":       "/charge",
+		"status":     float64(400),
+		"request_id": "req-1",
+	}
+	for k, v := range want {
+		if entry[k] != v {
+			t.Errorf("Logging logged %s = %v, want %v", k, entry[k], v)
+		}
+	}
+	if body, _ := entry["request_body"].(string); !strings.Contains(body, "************4081") {
+		t.Errorf("Logging logged request body %q, want the masked card number", body)
+	}
+}
+
+func TestLogging_largeBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	Use(Logging(slog.New(slog.NewJSONHandler(&buf, nil)), &LogOptions{Bodies: true}))(client)
+
+	name := strings.Repeat("a", 3*maxLoggedBody)
+	mux.HandleFunc("/bank", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"status": true, "message": "Banks retrieved", "data": [{"name": %q}]}`, name)
+	})
+
+	banks, _, err := client.Miscellaneous.ListBanks(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Miscellaneous.ListBanks returned error: %v", err)
+	}
+	if len(banks) != 1 || banks[0].GetName() != name {
+		t.Errorf("Miscellaneous.ListBanks did not return the whole response body")
+	}
+	var entry map[string]interface{}
+	json.Unmarshal(buf.Bytes(), &entry)
+	if body, _ := entry["response_body"].(string); !strings.HasSuffix(body, "...(truncated)") || len(body) > maxLoggedBody+20 {
+		t.Errorf("Logging logged a response body of %d bytes, want it truncated", len(body))
+	}
+}
+
+func TestLogged_truncatedSecret(t *testing.T) {
+	for _, secret := range []string{
+		`"otp": "123456"}`,
+		`"pin": 1234}`,
+		`"card": "4084 0840 8408 4081"}`,
+	} {
+		// Read only the logged prefix of a body, ending k bytes into secret.
+		for k := 1; k < len(secret); k++ {
+			pad := `{"data": "` + strings.Repeat("a", maxLoggedBody+1-k-13) + `", `
+			data := (pad + secret)[:maxLoggedBody+1]
+			want := pad[:len(pad)-1] + "...(truncated)"
+			if got := logged([]byte(data)); got != want {
+				t.Errorf("logged(%q) ends with %q", secret[:k], got[max(0, len(got)-40):])
+			}
+		}
+	}
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Bearer sk_live_abc123", "Bearer sk_live_[REDACTED]"},
+		{`{"pin": "1234", "otp":123456}`, `{"pin": "[REDACTED]", "otp":"[REDACTED]"}`},
+		{`{"bvn": "12345678901", "email": "a@b.c"}`, `{"bvn": "[REDACTED]", "email": "a@b.c"}`},
+		{"authorization_code=AUTH_x&amount=100", "authorization_code=[REDACTED]&amount=100"},
+		{"card 4084 0840 8408 4081", "card ************4081"},
+		{"account 0123456789012", "account 0123456789012"},
+	}
+	for _, tt := range tests {
+		if got := Redact(tt.in); got != tt.want {
+			t.Errorf("Redact(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	setup()
+	defer teardown()
+	Retry(RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond})(client)
+
+	type key struct{}
+	var calls []string
+	var attempts int
+	Observe(func(ctx context.Context, req *http.Request) (context.Context, func(*Response, error)) {
+		calls = append(calls, "start "+Operation(ctx))
+		return context.WithValue(ctx, key{}, "observed"), func(resp *Response, err error) {
+			calls = append(calls, "end")
+			attempts = resp.Attempts
+		}
+	})(client)
+	Use(OnRequest(func(r *http.Request) {
+		calls = append(calls, "attempt "+r.Context().Value(key{}).(string))
+	}))(client)
+
+	n := 0
+	mux.HandleFunc("/transaction/verify/T1", func(w http.ResponseWriter, r *http.Request) {
+		if n++; n == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"status": true, "message": "Verification successful", "data": {"reference": "T1"}}`)
+	})
+
+	if _, _, err := client.Transaction.Verify(context.Background(), "T1"); err != nil {
+		t.Fatalf("Transaction.Verify returned error: %v", err)
+	}
+	want := []string{"start Transaction.Verify", "attempt observed", "attempt observed", "end"}
+	if strings.Join(calls, ", ") != strings.Join(want, ", ") || attempts != 2 {
+		t.Errorf("Observer saw %v after %d attempts, want %v after 2", calls, attempts, want)
+	}
+}
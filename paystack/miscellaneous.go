@@ -3,23 +3,27 @@ package paystack
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 )
 
 //MiscellaneousService handles the communication with the Miscellaneous related parts of the Paystack API
 type MiscellaneousService service
 
+// Bank is a bank supported by Paystack. In a ChargeRequest, it is the bank
+// account to charge, identified by Code and AccountNumber.
 type Bank struct {
-	Name      *string     `json:"name, omitempty"`
-	Slug      *string     `json:"slug, omitempty"`
-	Code      *string     `json:"code, omitempty"`
-	Longcode  *string     `json:"longcode, omitempty"`
-	Gateway   *string     `json:"gateway, omitempty"`
-	Active    *bool       `json:"active, omitempty"`
-	IsDeleted interface{} `json:"is_deleted, omitempty"`
-	Id        *int        `json:"id, omitempty"`
-	CreatedAt *time.Time  `json:"created_at, omitempty"`
-	UpdatedAt *time.Time  `json:"updated_at, omitempty"`
+	Name          *string     `json:"name,omitempty"`
+	Slug          *string     `json:"slug,omitempty"`
+	Code          *string     `json:"code,omitempty"`
+	AccountNumber *string     `json:"account_number,omitempty"`
+	Longcode      *string     `json:"longcode,omitempty"`
+	Gateway       *string     `json:"gateway,omitempty"`
+	Active        *bool       `json:"active,omitempty"`
+	IsDeleted     interface{} `json:"is_deleted,omitempty"`
+	Id            *int        `json:"id,omitempty"`
+	CreatedAt     *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time  `json:"updated_at,omitempty"`
 }
 
 type Bin struct {
@@ -59,19 +63,25 @@ func (s *MiscellaneousService) ListBanks(ctx context.Context, opt *ListOptions)
 		return nil, nil, err
 	}
 
-	lr := new(StandardListResponse)
+	lr := new(Envelope[[]Bank])
 	resp, err := s.client.Do(ctx, req, lr)
-
 	if err != nil {
 		return nil, resp, err
 	}
-	var ba []Bank
-	b := new(Bank)
-	for _, x := range lr.Data {
-		mapDecoder(x, b)
-		ba = append(ba, *b)
+	return lr.Data, resp, nil
+}
+
+// ListAllBanks iterates over all banks, calling ListBanks for each page
+func (s *MiscellaneousService) ListAllBanks(ctx context.Context, opt *ListOptions, iopt *IterOptions) iter.Seq2[Bank, error] {
+	var o ListOptions
+	if opt != nil {
+		o = *opt
 	}
-	return ba, resp, nil
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]Bank, *Response, error) {
+		o := o
+		o.Page = page
+		return s.ListBanks(ctx, &o)
+	}, iopt)
 }
 
 // ResolveCardBin
@@ -84,14 +94,12 @@ func (s *MiscellaneousService) ResolveCardBin(ctx context.Context, id string) (*
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Bin])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	b := new(Bin)
-	mapDecoder(r.Data, b)
-	return b, resp, nil
+	return &r.Data, resp, nil
 }
 
 // ResolveBvn
@@ -104,14 +112,12 @@ func (s *MiscellaneousService) ResolveBvn(ctx context.Context, id string) (*BvnD
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[BvnData])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	b := new(BvnData)
-	mapDecoder(r.Data, b)
-	return b, resp, nil
+	return &r.Data, resp, nil
 }
 
 // ResolveAccountNumber
@@ -128,12 +134,10 @@ func (s *MiscellaneousService) ResolveAccountNumber(ctx context.Context, opt *Li
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[AccountData])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	c := new(AccountData)
-	mapDecoder(r.Data, c)
-	return c, resp, nil
+	return &r.Data, resp, nil
 }
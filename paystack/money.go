@@ -0,0 +1,423 @@
+package paystack
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Currency is an ISO 4217 currency code supported by Paystack.
+type Currency string
+
+const (
+	NGN Currency = "NGN" // Nigerian naira, in kobo
+	GHS Currency = "GHS" // Ghanaian cedi, in pesewas
+	ZAR Currency = "ZAR" // South African rand, in cents
+	USD Currency = "USD" // US dollar, in cents
+	KES Currency = "KES" // Kenyan shilling, in cents
+)
+
+// currencySubunits holds the number of minor units in a major unit of each
+// known currency.
+var currencySubunits = map[Currency]int64{
+	NGN: 100,
+	GHS: 100,
+	ZAR: 100,
+	USD: 100,
+	KES: 100,
+}
+
+// Known reports whether c is one of the currencies defined by this package.
+func (c Currency) Known() bool {
+	_, ok := currencySubunits[c]
+	return ok
+}
+
+// Subunit returns the number of minor units in a major unit of c, such as
+// 100 kobo to the naira. Unknown currencies are assumed to have 100.
+func (c Currency) Subunit() int64 {
+	if n, ok := currencySubunits[c]; ok {
+		return n
+	}
+	return 100
+}
+
+func (c Currency) String() string { return string(c) }
+
+// Ptr returns a pointer to a copy of c, for use in request structs.
+func (c Currency) Ptr() *Currency { return &c }
+
+// ErrCurrencyMismatch is returned when amounts in different currencies are
+// combined or compared.
+var ErrCurrencyMismatch = errors.New("paystack: currency mismatch")
+
+// Money is an amount in the minor unit of a currency, such as kobo for NGN.
+// Paystack expects every amount in the minor unit, so that 5000 NGN kobo is
+// 50 naira.
+//
+// On the wire a Money is the bare integer amount; its currency is taken from
+// the currency field of the enclosing object when a response is decoded, and
+// sent as the currency of the request when it is encoded.
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+// NewMoney returns a pointer to the Money of amount minor units of c, for
+// use in request structs.
+func NewMoney(amount int64, c Currency) *Money {
+	return &Money{Amount: amount, Currency: c}
+}
+
+// ParseMoney parses a decimal amount in the major unit of c, such as
+// "1500.50" for 1500 naira and 50 kobo. It fails if s has more decimal places
+// than the currency has subunits.
+func ParseMoney(s string, c Currency) (*Money, error) {
+	str := strings.TrimSpace(s)
+	neg := strings.HasPrefix(str, "-")
+	str = strings.TrimPrefix(str, "-")
+	whole, frac, _ := strings.Cut(str, ".")
+	decimals := len(strconv.FormatInt(c.Subunit(), 10)) - 1
+	if whole == "" || len(frac) > decimals || !isDigits(whole) || !isDigits(frac) {
+		return nil, fmt.Errorf("paystack: invalid %s amount %q", c, s)
+	}
+	frac += strings.Repeat("0", decimals-len(frac))
+	major, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("paystack: invalid %s amount %q: %v", c, s, err)
+	}
+	minor, _ := strconv.ParseInt("0"+frac, 10, 64)
+	amount := major*c.Subunit() + minor
+	if neg {
+		amount = -amount
+	}
+	return NewMoney(amount, c), nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Decimal formats m in the major unit of its currency, such as "1500.50".
+func (m Money) Decimal() string {
+	sub := m.Currency.Subunit()
+	a := m.Amount
+	sign := ""
+	if a < 0 {
+		sign, a = "-", -a
+	}
+	decimals := len(strconv.FormatInt(sub, 10)) - 1
+	if decimals == 0 {
+		return sign + strconv.FormatInt(a, 10)
+	}
+	return fmt.Sprintf("%s%d.%0*d", sign, a/sub, decimals, a%sub)
+}
+
+// String formats m with its currency, such as "NGN 1500.50".
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Decimal()
+	}
+	return string(m.Currency) + " " + m.Decimal()
+}
+
+// IsZero reports whether m is a zero amount.
+func (m Money) IsZero() bool { return m.Amount == 0 }
+
+// currencyWith returns the currency of the result of combining m and o. An
+// unset currency takes the other one.
+func (m Money) currencyWith(o Money) (Currency, error) {
+	switch {
+	case m.Currency == o.Currency || o.Currency == "":
+		return m.Currency, nil
+	case m.Currency == "":
+		return o.Currency, nil
+	}
+	return "", fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+}
+
+// Add returns m + o. It fails with ErrCurrencyMismatch if they are in
+// different currencies.
+func (m Money) Add(o Money) (Money, error) {
+	c, err := m.currencyWith(o)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount + o.Amount, Currency: c}, nil
+}
+
+// Sub returns m - o. It fails with ErrCurrencyMismatch if they are in
+// different currencies.
+func (m Money) Sub(o Money) (Money, error) {
+	c, err := m.currencyWith(o)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount - o.Amount, Currency: c}, nil
+}
+
+// Mul returns m multiplied by n, such as the price of n items.
+func (m Money) Mul(n int64) Money {
+	return Money{Amount: m.Amount * n, Currency: m.Currency}
+}
+
+// Cmp compares m and o, returning -1, 0 or +1. It fails with
+// ErrCurrencyMismatch if they are in different currencies.
+func (m Money) Cmp(o Money) (int, error) {
+	if _, err := m.currencyWith(o); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.Amount < o.Amount:
+		return -1, nil
+	case m.Amount > o.Amount:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// MarshalJSON encodes m as its amount in the minor unit.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(m.Amount, 10)), nil
+}
+
+// UnmarshalJSON accepts the amount as a number or a string, or an object
+// with amount and currency fields as returned in totals by currency. It
+// fails on amounts that are not a whole number of minor units.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	switch {
+	case s == "null":
+		return nil
+	case strings.HasPrefix(s, "{"):
+		var v struct {
+			Amount   *Money   `json:"amount"`
+			Currency Currency `json:"currency"`
+		}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		if v.Amount != nil {
+			m.Amount = v.Amount.Amount
+		}
+		m.Currency = v.Currency
+		return nil
+	}
+	s = strings.Trim(s, `"`)
+	if s == "" {
+		return nil
+	}
+	// Tolerate amounts sent with a zero fractional part, such as "5000.00",
+	// but not fractions of the minor unit.
+	if whole, frac, ok := strings.Cut(s, "."); ok && frac != "" && strings.Trim(frac, "0") == "" {
+		s = whole
+	}
+	a, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return &json.UnmarshalTypeError{Value: "number " + s, Type: moneyType}
+	}
+	m.Amount = a
+	return nil
+}
+
+// EncodeValues encodes m as its amount in the minor unit in query strings.
+func (m Money) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, strconv.FormatInt(m.Amount, 10))
+	return nil
+}
+
+var (
+	moneyType    = reflect.TypeOf(Money{})
+	currencyType = reflect.TypeOf(Currency(""))
+)
+
+// moneyFields describes where Money and Currency values are found in a
+// struct type.
+type moneyFields struct {
+	currency int   // index of the Currency or *Currency field, or -1
+	money    []int // indexes of the Money and *Money fields
+	nested   []int // indexes of other fields that may hold Money
+}
+
+var moneyFieldsCache sync.Map // map[reflect.Type]*moneyFields
+
+func fieldsOf(t reflect.Type) *moneyFields {
+	if f, ok := moneyFieldsCache.Load(t); ok {
+		return f.(*moneyFields)
+	}
+	f := &moneyFields{currency: -1}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		ft := sf.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		switch {
+		case ft == currencyType:
+			if f.currency < 0 {
+				f.currency = i
+			}
+		case ft == moneyType:
+			f.money = append(f.money, i)
+		case mayHoldMoney(sf.Type, map[reflect.Type]bool{}):
+			f.nested = append(f.nested, i)
+		}
+	}
+	moneyFieldsCache.Store(t, f)
+	return f
+}
+
+// mayHoldMoney reports whether values of type t can contain a Money.
+func mayHoldMoney(t reflect.Type, seen map[reflect.Type]bool) bool {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || seen[t] {
+		return false
+	}
+	if t == moneyType {
+		return true
+	}
+	seen[t] = true
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() && mayHoldMoney(t.Field(i).Type, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// bindCurrency sets the currency of every Money in v that has none to the
+// currency of the nearest enclosing object, after a response is decoded.
+func bindCurrency(v reflect.Value, c Currency) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			bindCurrency(v.Elem(), c)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			bindCurrency(v.Index(i), c)
+		}
+	case reflect.Struct:
+		if v.Type() == moneyType {
+			if m := v.Addr().Interface().(*Money); m.Currency == "" {
+				m.Currency = c
+			}
+			return
+		}
+		f := fieldsOf(v.Type())
+		if f.currency >= 0 {
+			if own := currencyOf(v.Field(f.currency)); own != "" {
+				c = own
+			}
+		}
+		if c != "" {
+			for _, i := range f.money {
+				bindCurrency(v.Field(i), c)
+			}
+		}
+		for _, i := range f.nested {
+			bindCurrency(v.Field(i), c)
+		}
+	}
+}
+
+func currencyOf(v reflect.Value) Currency {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	return v.Interface().(Currency)
+}
+
+// collectCurrencies adds the currencies of the Money values in v, and of
+// the currency fields of the structs holding them, to set.
+func collectCurrencies(v reflect.Value, set map[Currency]bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			collectCurrencies(v.Elem(), set)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			collectCurrencies(v.Index(i), set)
+		}
+	case reflect.Struct:
+		if v.Type() == moneyType {
+			if c := v.Interface().(Money).Currency; c != "" {
+				set[c] = true
+			}
+			return
+		}
+		f := fieldsOf(v.Type())
+		if f.currency >= 0 {
+			if c := currencyOf(v.Field(f.currency)); c != "" {
+				set[c] = true
+			}
+		}
+		for _, i := range f.money {
+			collectCurrencies(v.Field(i), set)
+		}
+		for _, i := range f.nested {
+			collectCurrencies(v.Field(i), set)
+		}
+	}
+}
+
+// withCurrency prepares a request body holding Money values. It fails if
+// the amounts found anywhere in body, such as in the items of a bulk
+// request, and the currency fields of the structs holding them disagree.
+// If body is a pointer to a struct with a currency field, that field is set
+// from the currency of its amounts when it is unset, on a copy of body.
+func withCurrency(body interface{}) (interface{}, error) {
+	v := reflect.ValueOf(body)
+	if !v.IsValid() || !mayHoldMoney(v.Type(), map[reflect.Type]bool{}) {
+		return body, nil
+	}
+	set := map[Currency]bool{}
+	collectCurrencies(v, set)
+	if len(set) > 1 {
+		var cs []string
+		for c := range set {
+			cs = append(cs, string(c))
+		}
+		sort.Strings(cs)
+		return nil, fmt.Errorf("%w: request mixes %s", ErrCurrencyMismatch, strings.Join(cs, ", "))
+	}
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct || len(set) == 0 {
+		return body, nil
+	}
+	f := fieldsOf(v.Elem().Type())
+	if f.currency < 0 || currencyOf(v.Elem().Field(f.currency)) != "" {
+		return body, nil
+	}
+	var c Currency
+	for c = range set {
+	}
+	cp := reflect.New(v.Elem().Type())
+	cp.Elem().Set(v.Elem())
+	field := cp.Elem().Field(f.currency)
+	if field.Kind() == reflect.Ptr {
+		field.Set(reflect.ValueOf(&c))
+	} else {
+		field.Set(reflect.ValueOf(c))
+	}
+	return cp.Interface(), nil
+}
@@ -0,0 +1,173 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int64
+	}{
+		{"1500", 150000},
+		{"1500.5", 150050},
+		{"1500.50", 150050},
+		{"0.01", 1},
+		{"-2.30", -230},
+	}
+	for _, tt := range tests {
+		m, err := ParseMoney(tt.in, GHS)
+		if err != nil {
+			t.Errorf("ParseMoney(%q) returned error: %v", tt.in, err)
+			continue
+		}
+		if want := (Money{Amount: tt.want, Currency: GHS}); *m != want {
+			t.Errorf("ParseMoney(%q) = %v, want %v", tt.in, *m, want)
+		}
+	}
+
+	for _, in := range []string{"", "1.005", "1,000", "abc", ".5", "1.-5"} {
+		if _, err := ParseMoney(in, NGN); err == nil {
+			t.Errorf("ParseMoney(%q) returned no error", in)
+		}
+	}
+}
+
+func TestMoney_String(t *testing.T) {
+	tests := []struct {
+		m    Money
+		want string
+	}{
+		{Money{Amount: 150050, Currency: NGN}, "NGN 1500.50"},
+		{Money{Amount: 5, Currency: KES}, "KES 0.05"},
+		{Money{Amount: -230, Currency: ZAR}, "ZAR -2.30"},
+		{Money{Amount: 1000}, "10.00"},
+	}
+	for _, tt := range tests {
+		if got := tt.m.String(); got != tt.want {
+			t.Errorf("%#v.String() = %q, want %q", tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestMoney_arithmetic(t *testing.T) {
+	a := Money{Amount: 1000, Currency: NGN}
+
+	sum, err := a.Add(Money{Amount: 250, Currency: NGN})
+	if err != nil || sum != (Money{Amount: 1250, Currency: NGN}) {
+		t.Errorf("Add returned %v, %v, want NGN 12.50", sum, err)
+	}
+	diff, err := a.Sub(Money{Amount: 250})
+	if err != nil || diff != (Money{Amount: 750, Currency: NGN}) {
+		t.Errorf("Sub returned %v, %v, want NGN 7.50", diff, err)
+	}
+	if got := a.Mul(3); got != (Money{Amount: 3000, Currency: NGN}) {
+		t.Errorf("Mul returned %v, want NGN 30.00", got)
+	}
+	if c, err := a.Cmp(Money{Amount: 999, Currency: NGN}); err != nil || c != 1 {
+		t.Errorf("Cmp returned %d, %v, want 1", c, err)
+	}
+
+	usd := Money{Amount: 1000, Currency: USD}
+	if _, err := a.Add(usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Add across currencies returned %v, want ErrCurrencyMismatch", err)
+	}
+	if _, err := a.Sub(usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Sub across currencies returned %v, want ErrCurrencyMismatch", err)
+	}
+	if _, err := a.Cmp(usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Cmp across currencies returned %v, want ErrCurrencyMismatch", err)
+	}
+}
+
+func TestMoney_JSON(t *testing.T) {
+	var v struct {
+		A, B, C, D *Money
+	}
+	err := json.Unmarshal([]byte(`{"A": 5000, "B": "5000", "C": {"currency": "USD", "amount": "5000"}, "D": null}`), &v)
+	if err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	want := []*Money{{Amount: 5000}, {Amount: 5000}, {Amount: 5000, Currency: USD}, nil}
+	if got := []*Money{v.A, v.B, v.C, v.D}; !cmp.Equal(got, want) {
+		t.Errorf("json.Unmarshal decoded %v, want %v", got, want)
+	}
+
+	var m Money
+	if err := json.Unmarshal([]byte(`"5000.00"`), &m); err != nil || m.Amount != 5000 {
+		t.Errorf("json.Unmarshal of \"5000.00\" decoded %v, %v, want 5000", m, err)
+	}
+	for _, in := range []string{`"12.5"`, `12.5`, `1e3`, `"99999999999999999999"`} {
+		if err := json.Unmarshal([]byte(in), &m); err == nil {
+			t.Errorf("json.Unmarshal of %s returned no error", in)
+		}
+	}
+
+	b, err := json.Marshal(NewMoney(5000, NGN))
+	if err != nil || string(b) != "5000" {
+		t.Errorf("json.Marshal returned %s, %v, want 5000", b, err)
+	}
+}
+
+func TestMoney_bindsCurrencyOfEnclosingObject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/refund/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Refund retrieved",
+		  "data": {
+			"transaction": {"id": 1004723697, "amount": 3000, "currency": "GHS"},
+			"amount": 1000,
+			"deducted_amount": 0,
+			"currency": "USD",
+			"id": 1
+		  }
+		}`)
+	})
+
+	refund, _, err := client.Refund.Fetch(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Refund.Fetch returned error: %v", err)
+	}
+	if got, want := refund.GetAmount(), (Money{Amount: 1000, Currency: USD}); got != want {
+		t.Errorf("Refund.Amount = %v, want %v", got, want)
+	}
+	if got, want := refund.Transaction.GetAmount(), (Money{Amount: 3000, Currency: GHS}); got != want {
+		t.Errorf("Refund.Transaction.Amount = %v, want %v", got, want)
+	}
+}
+
+func TestNewRequest_currency(t *testing.T) {
+	c := NewClient(nil)
+
+	req, err := c.NewRequest("POST", "transfer", &TransferRequest{Amount: NewMoney(100, GHS)})
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	var body map[string]interface{}
+	json.NewDecoder(req.Body).Decode(&body)
+	if body["currency"] != "GHS" {
+		t.Errorf("NewRequest sent currency %v, want GHS", body["currency"])
+	}
+
+	_, err = c.NewRequest("POST", "transfer", &TransferRequest{Amount: NewMoney(100, GHS), Currency: NGN.Ptr()})
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("NewRequest with mixed currencies returned %v, want ErrCurrencyMismatch", err)
+	}
+	_, err = c.NewRequest("POST", "bulkcharge", []*BulkBatchRequest{
+		{Authorization: String("AUTH_n95vpedf"), Amount: NewMoney(2500, NGN)},
+		{Authorization: String("AUTH_ljdt4e4j"), Amount: NewMoney(1500, USD)},
+	})
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("NewRequest with mixed currencies in bulk items returned %v, want ErrCurrencyMismatch", err)
+	}
+}
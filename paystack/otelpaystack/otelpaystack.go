@@ -0,0 +1,163 @@
+// Copyright 2017 The go-paystack AUTHORS. All rights reserved.
+
+// Package otelpaystack instruments a Paystack client with OpenTelemetry.
+//
+// Every API call gets a client span named after the service method, such as
+// "Transaction.Verify", recording the HTTP status, the Paystack error code
+// and the number of retries. The duration of calls is recorded in a
+// histogram and failed calls are counted.
+//
+//	client := paystack.NewClient(nil,
+//		paystack.SecretKey("sk_live_your_secret_key"),
+//		otelpaystack.Instrument(nil),
+//	)
+//
+// Only the method, operation and outcome of calls are recorded. URLs,
+// bodies and error messages are left out, as they may carry the secret key,
+// card data, BVNs or OTPs.
+package otelpaystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kehindesalaam/go-paystack/paystack"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope of the spans and metrics.
+const ScopeName = "github.com/kehindesalaam/go-paystack/paystack/otelpaystack"
+
+// Attribute keys recorded on spans and metrics.
+const (
+	OperationKey  = attribute.Key("paystack.operation")
+	ErrorCodeKey  = attribute.Key("paystack.error.code")
+	RetryCountKey = attribute.Key("paystack.retry_count")
+	RequestIDKey  = attribute.Key("paystack.request_id")
+
+	methodKey        = attribute.Key("http.request.method")
+	statusCodeKey    = attribute.Key("http.response.status_code")
+	serverAddressKey = attribute.Key("server.address")
+	errorTypeKey     = attribute.Key("error.type")
+)
+
+// Options configures Instrument.
+type Options struct {
+	// TracerProvider creates the tracer, otel.GetTracerProvider() by
+	// default.
+	TracerProvider trace.TracerProvider
+
+	// MeterProvider creates the instruments, otel.GetMeterProvider() by
+	// default.
+	MeterProvider metric.MeterProvider
+}
+
+// Instrument is a referential function that instruments the client when
+// initializing it. opt may be nil.
+func Instrument(opt *Options) func(*paystack.Client) {
+	var o Options
+	if opt != nil {
+		o = *opt
+	}
+	if o.TracerProvider == nil {
+		o.TracerProvider = otel.GetTracerProvider()
+	}
+	if o.MeterProvider == nil {
+		o.MeterProvider = otel.GetMeterProvider()
+	}
+	return paystack.Observe(newObserver(&o).observe)
+}
+
+type observer struct {
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+}
+
+func newObserver(o *Options) *observer {
+	meter := o.MeterProvider.Meter(ScopeName)
+	duration, err := meter.Float64Histogram("paystack.client.call.duration",
+		metric.WithDescription("Duration of Paystack API calls, including retries."),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	errs, err := meter.Int64Counter("paystack.client.call.errors",
+		metric.WithDescription("Number of failed Paystack API calls."),
+		metric.WithUnit("{call}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	return &observer{
+		tracer:   o.TracerProvider.Tracer(ScopeName),
+		duration: duration,
+		errors:   errs,
+	}
+}
+
+// observe starts the span of a call and returns the function ending it.
+func (o *observer) observe(ctx context.Context, req *http.Request) (context.Context, func(*paystack.Response, error)) {
+	op := paystack.Operation(ctx)
+	name := op
+	if name == "" {
+		name = req.Method
+	}
+	attrs := []attribute.KeyValue{methodKey.String(req.Method)}
+	if op != "" {
+		attrs = append(attrs, OperationKey.String(op))
+	}
+
+	start := time.Now()
+	ctx, span := o.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+		trace.WithAttributes(serverAddressKey.String(req.URL.Hostname())))
+
+	return ctx, func(resp *paystack.Response, err error) {
+		if resp != nil && resp.Response != nil {
+			attrs = append(attrs, statusCodeKey.Int(resp.StatusCode))
+			span.SetAttributes(RetryCountKey.Int(max(resp.Attempts-1, 0)))
+			if id := resp.Header.Get("X-Request-Id"); id != "" {
+				span.SetAttributes(RequestIDKey.String(id))
+			}
+		}
+		if err != nil {
+			attrs = append(attrs, errorTypeKey.String(errorType(resp, err)))
+			var er *paystack.ErrorResponse
+			if errors.As(err, &er) && er.Code != "" {
+				attrs = append(attrs, ErrorCodeKey.String(er.Code))
+			}
+			// The message of err is left out as it may quote the request.
+			span.SetStatus(codes.Error, errorType(resp, err))
+		}
+		span.SetAttributes(attrs...)
+		span.End()
+
+		set := metric.WithAttributes(attrs...)
+		o.duration.Record(ctx, time.Since(start).Seconds(), set)
+		if err != nil {
+			o.errors.Add(ctx, 1, set)
+		}
+	}
+}
+
+// errorType classifies the error of a call with a low cardinality value:
+// the HTTP status code of an API error, or the kind of a transport error.
+func errorType(resp *paystack.Response, err error) string {
+	switch {
+	case resp != nil && resp.Response != nil && resp.StatusCode >= 400:
+		return strconv.Itoa(resp.StatusCode)
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	}
+	return "_OTHER"
+}
@@ -0,0 +1,136 @@
+package otelpaystack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kehindesalaam/go-paystack/paystack"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setup(t *testing.T, h http.HandlerFunc) (*paystack.Client, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	server := httptest.NewServer(h)
+	t.Cleanup(server.Close)
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	client := paystack.NewClient(nil,
+		paystack.SecretKey("sk_test_0123456789abcdef"),
+		paystack.Retry(paystack.RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond}),
+		Instrument(&Options{
+			TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)),
+			MeterProvider:  sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+		}),
+	)
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	return client, spans, reader
+}
+
+func attrs(kvs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	m := make(map[attribute.Key]attribute.Value)
+	for _, kv := range kvs {
+		m[kv.Key] = kv.Value
+	}
+	return m
+}
+
+func TestInstrument_span(t *testing.T) {
+	calls := 0
+	client, spans, _ := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls++; calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("X-Request-Id", "req-1")
+		fmt.Fprint(w, `{"status": true, "message": "Verification successful", "data": {"reference": "T1"}}`)
+	})
+
+	if _, _, err := client.Transaction.Verify(context.Background(), "T1"); err != nil {
+		t.Fatalf("Transaction.Verify returned error: %v", err)
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("Recorded %d spans, want 1", len(ended))
+	}
+	s := ended[0]
+	if s.Name() != "Transaction.Verify" || s.SpanKind() != trace.SpanKindClient {
+		t.Errorf("Recorded span %q of kind %v, want client span Transaction.Verify", s.Name(), s.SpanKind())
+	}
+	got := attrs(s.Attributes())
+	want := map[attribute.Key]interface{}{
+		OperationKey:                "Transaction.Verify",
+		RetryCountKey:               1,
+		RequestIDKey:                "req-1",
+		"http.request.method":       "GET",
+		"http.response.status_code": 200,
+	}
+	for k, v := range want {
+		if got[k].Emit() != fmt.Sprint(v) {
+			t.Errorf("Span attribute %s = %v, want %v", k, got[k].Emit(), v)
+		}
+	}
+	if s.Status().Code == codes.Error {
+		t.Errorf("Span status = %v, want unset", s.Status())
+	}
+}
+
+func TestInstrument_error(t *testing.T) {
+	client, spans, reader := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"status": false, "message": "Authorization AUTH_8dfhjjdt not found", "code": "invalid_authorization"}`)
+	})
+
+	client.Customer.DeactivateAuthorization(context.Background(), &paystack.Authorization{AuthorizationCode: paystack.String("AUTH_8dfhjjdt")})
+
+	s := spans.Ended()[0]
+	got := attrs(s.Attributes())
+	if got[ErrorCodeKey].AsString() != "invalid_authorization" || got["error.type"].AsString() != "400" {
+		t.Errorf("Span attributes = %v, want the Paystack error code and error type", s.Attributes())
+	}
+	if s.Status().Code != codes.Error {
+		t.Errorf("Span status = %v, want error", s.Status())
+	}
+	for _, kv := range s.Attributes() {
+		if v := kv.Value.Emit(); strings.Contains(v, "AUTH_8dfhjjdt") || strings.Contains(v, "sk_test") {
+			t.Errorf("Span attribute %s leaks %q", kv.Key, v)
+		}
+	}
+	if strings.Contains(s.Status().Description, "AUTH_8dfhjjdt") {
+		t.Errorf("Span status leaks %q", s.Status().Description)
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect returned error: %v", err)
+	}
+	metrics := make(map[string]metricdata.Aggregation)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+	h, ok := metrics["paystack.client.call.duration"].(metricdata.Histogram[float64])
+	if !ok || len(h.DataPoints) != 1 || h.DataPoints[0].Count != 1 {
+		t.Errorf("paystack.client.call.duration = %+v, want one call", metrics["paystack.client.call.duration"])
+	}
+	c, ok := metrics["paystack.client.call.errors"].(metricdata.Sum[int64])
+	if !ok || len(c.DataPoints) != 1 || c.DataPoints[0].Value != 1 {
+		t.Fatalf("paystack.client.call.errors = %+v, want one error", metrics["paystack.client.call.errors"])
+	}
+	if v, _ := c.DataPoints[0].Attributes.Value(OperationKey); v.AsString() != "Customer.DeactivateAuthorization" {
+		t.Errorf("paystack.client.call.errors operation = %q, want Customer.DeactivateAuthorization", v.AsString())
+	}
+}
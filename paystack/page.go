@@ -3,6 +3,7 @@ package paystack
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 )
 
@@ -15,13 +16,15 @@ type Page struct {
 	Domain       *string      `json:"domain, omitempty"`
 	Name         *string      `json:"name, omitempty"`
 	Description  *string      `json:"description, omitempty"`
-	Amount       *int         `json:"amount, omitempty"`
-	Currency     *string      `json:"currency, omitempty"`
+	Amount       *Money       `json:"amount, omitempty"`
+	Currency     *Currency    `json:"currency, omitempty"`
 	Slug         *string      `json:"slug, omitempty"`
 	CustomFields CustomFields `json:"custom_fields, omitempty"`
 	RedirectUrl  *string      `json:"redirect_url, omitempty"`
 	Active       *bool        `json:"active, omitempty"`
 	Migrate      interface{}  `json:"migrate, omitempty"`
+	Type         *string      `json:"type,omitempty"`
+	Products     []Product    `json:"products,omitempty"`
 	Id           *int         `json:"id, omitempty"`
 	CreatedAt    *time.Time   `json:"created_at, omitempty"`
 	UpdatedAt    *time.Time   `json:"updated_at, omitempty"`
@@ -30,12 +33,13 @@ type Page struct {
 type PageRequest struct {
 	Name         *string      `json:"name, omitempty"`
 	Description  *string      `json:"description, omitempty"`
-	Amount       *int         `json:"amount, omitempty"`
-	Currency     *string      `json:"currency, omitempty"`
+	Amount       *Money       `json:"amount, omitempty"`
+	Currency     *Currency    `json:"currency, omitempty"`
 	Slug         *string      `json:"slug, omitempty"`
 	CustomFields CustomFields `json:"custom_fields, omitempty"`
 	RedirectUrl  *string      `json:"redirect_url, omitempty"`
 	Active       *bool        `json:"active, omitempty"`
+	Type         *string      `json:"type,omitempty"`
 	Id           *int         `json:"id, omitempty"`
 }
 
@@ -55,14 +59,12 @@ func (s *PageService) Create(ctx context.Context, sa *PageRequest) (*Page, *Resp
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Page])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	p := new(Page)
-	mapDecoder(r.Data, p)
-	return p, resp, nil
+	return &r.Data, resp, nil
 }
 
 // List returns all created pages
@@ -81,19 +83,25 @@ func (s *PageService) List(ctx context.Context, opt *ListOptions) ([]Page, *Resp
 		return nil, nil, err
 	}
 
-	lr := new(StandardListResponse)
+	lr := new(Envelope[[]Page])
 	resp, err := s.client.Do(ctx, req, lr)
-
 	if err != nil {
 		return nil, resp, err
 	}
-	var pa []Page
-	p := new(Page)
-	for _, x := range lr.Data {
-		mapDecoder(x, p)
-		pa = append(pa, *p)
+	return lr.Data, resp, nil
+}
+
+// ListAll iterates over all payment pages, calling List for each one
+func (s *PageService) ListAll(ctx context.Context, opt *ListOptions, iopt *IterOptions) iter.Seq2[Page, error] {
+	var o ListOptions
+	if opt != nil {
+		o = *opt
 	}
-	return pa, resp, nil
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]Page, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
 }
 
 // Fetch fetches a page
@@ -106,14 +114,12 @@ func (s *PageService) Fetch(ctx context.Context, id string) (*Page, *Response, e
 	if err != nil {
 		return nil, nil, err
 	}
-	c := new(StandardResponse)
+	c := new(Envelope[Page])
 	resp, err := s.client.Do(ctx, req, c)
 	if err != nil {
 		return nil, resp, err
 	}
-	p := new(Page)
-	mapDecoder(c.Data, p)
-	return p, resp, nil
+	return &c.Data, resp, nil
 }
 
 // Update updates a page model with the page
@@ -126,14 +132,12 @@ func (s *PageService) Update(ctx context.Context, sa *PageRequest, id string) (*
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Page])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	p := new(Page)
-	mapDecoder(r.Data, p)
-	return p, resp, nil
+	return &r.Data, resp, nil
 }
 
 // CheckSlugAvailability checks if a slug is available
@@ -146,12 +150,32 @@ func (s *PageService) CheckSlugAvailability(ctx context.Context, id string) (*Me
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Message])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// AddProducts adds products, given their ids, to a page. The page must be of
+// the "product" type.
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#add-products
+func (s *PageService) AddProducts(ctx context.Context, id string, products []int) (*Page, *Response, error) {
+	u := fmt.Sprintf("page/" + id + "/product")
+	body := struct {
+		Product []int `json:"product"`
+	}{products}
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Page])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(Message)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
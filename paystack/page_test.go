@@ -0,0 +1,58 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPageService_AddProducts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/page/102859/product", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if want := map[string]interface{}{"product": []interface{}{float64(473), float64(292)}}; !cmp.Equal(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Products added to page",
+		  "data": {
+			"id": 102859,
+			"name": "Product Page",
+			"slug": "product-page",
+			"type": "product",
+			"products": [
+			  {"product_id": 473, "name": "Cake", "price": 2000, "id": 473},
+			  {"product_id": 292, "name": "Bread", "price": 500, "id": 292}
+			]
+		  }
+		}`)
+	})
+
+	page, _, err := client.Page.AddProducts(context.Background(), "102859", []int{473, 292})
+	if err != nil {
+		t.Errorf("Page.AddProducts returned error: %v", err)
+	}
+
+	want := &Page{
+		Id:   Int(102859),
+		Name: String("Product Page"),
+		Slug: String("product-page"),
+		Type: String("product"),
+		Products: []Product{
+			{Name: String("Cake"), Price: &Money{Amount: 2000}, Id: Int(473)},
+			{Name: String("Bread"), Price: &Money{Amount: 500}, Id: Int(292)},
+		},
+	}
+	if !cmp.Equal(page, want) {
+		t.Errorf("Page.AddProducts returned %+v, want %+v", page, want)
+	}
+}
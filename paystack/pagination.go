@@ -0,0 +1,100 @@
+package paystack
+
+import (
+	"context"
+	"iter"
+)
+
+// IterOptions specifies the optional parameters to the ListAll family of
+// methods, which iterate over every page of a list endpoint.
+type IterOptions struct {
+	// MaxItems stops the iteration once this many items have been yielded.
+	// Zero means no limit.
+	MaxItems int
+
+	// Prefetch fetches the next page in the background while the items of
+	// the current page are being consumed.
+	Prefetch bool
+}
+
+// PageFetcher fetches a single page of a paginated result set.
+type PageFetcher[T any] func(ctx context.Context, page int) ([]T, *Response, error)
+
+type pageResult[T any] struct {
+	items []T
+	resp  *Response
+	err   error
+}
+
+// Iter returns an iterator over every item of a paginated result set,
+// starting at page start and following Response.NextPage until the last
+// page, ctx is done or opt.MaxItems items have been yielded. Errors are
+// yielded once, with the zero value of T, and end the iteration.
+//
+// Most callers will use the ListAll methods of the services rather than
+// calling Iter directly.
+func Iter[T any](ctx context.Context, start int, fetch PageFetcher[T], opt *IterOptions) iter.Seq2[T, error] {
+	if opt == nil {
+		opt = &IterOptions{}
+	}
+	if start < 1 {
+		start = 1
+	}
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// get returns a function that returns the given page. With
+		// Prefetch the page is requested right away in the background,
+		// otherwise only once the function is called.
+		get := func(page int) func() pageResult[T] {
+			if !opt.Prefetch {
+				return func() pageResult[T] {
+					items, resp, err := fetch(ctx, page)
+					return pageResult[T]{items, resp, err}
+				}
+			}
+			ch := make(chan pageResult[T], 1)
+			go func() {
+				items, resp, err := fetch(ctx, page)
+				ch <- pageResult[T]{items, resp, err}
+			}()
+			return func() pageResult[T] { return <-ch }
+		}
+
+		var zero T
+		yielded := 0
+		page := start
+		next := get(page)
+		for next != nil {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			r := next()
+			if r.err != nil {
+				yield(zero, r.err)
+				return
+			}
+
+			// A page that does not move forward would loop forever.
+			next = nil
+			if r.resp != nil && r.resp.NextPage > page {
+				page = r.resp.NextPage
+				if opt.MaxItems == 0 || yielded+len(r.items) < opt.MaxItems {
+					next = get(page)
+				}
+			}
+
+			for _, item := range r.items {
+				if opt.MaxItems > 0 && yielded >= opt.MaxItems {
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+				yielded++
+			}
+		}
+	}
+}
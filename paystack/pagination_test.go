@@ -0,0 +1,106 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// servePages registers a handler on /customer that serves pageCount pages
+// of two customers each, and returns a pointer to the number of calls.
+func servePages(t *testing.T, pageCount int) *int {
+	calls := 0
+	mux.HandleFunc("/customer", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		fmt.Fprintf(w, `{
+		  "status": true,
+		  "message": "Customers retrieved",
+		  "data": [{"id": %d}, {"id": %d}],
+		  "meta": {"total": %d, "perPage": 2, "page": %d, "pageCount": %d}
+		}`, page*10+1, page*10+2, pageCount*2, page, pageCount)
+	})
+	return &calls
+}
+
+func TestCustomerService_ListAll(t *testing.T) {
+	for _, prefetch := range []bool{false, true} {
+		t.Run(fmt.Sprintf("prefetch=%v", prefetch), func(t *testing.T) {
+			setup()
+			defer teardown()
+			calls := servePages(t, 3)
+
+			var ids []int
+			for c, err := range client.Customer.ListAll(context.Background(), &ListOptions{PerPage: 2}, &IterOptions{Prefetch: prefetch}) {
+				if err != nil {
+					t.Fatalf("Customer.ListAll returned error: %v", err)
+				}
+				ids = append(ids, c.GetId())
+			}
+			want := []int{11, 12, 21, 22, 31, 32}
+			if fmt.Sprint(ids) != fmt.Sprint(want) {
+				t.Errorf("Customer.ListAll yielded %v, want %v", ids, want)
+			}
+			if *calls != 3 {
+				t.Errorf("server received %d calls, want 3", *calls)
+			}
+		})
+	}
+}
+
+func TestCustomerService_ListAll_maxItems(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := servePages(t, 5)
+
+	n := 0
+	for _, err := range client.Customer.ListAll(context.Background(), &ListOptions{Page: 2, PerPage: 2}, &IterOptions{MaxItems: 3}) {
+		if err != nil {
+			t.Fatalf("Customer.ListAll returned error: %v", err)
+		}
+		n++
+	}
+	if n != 3 {
+		t.Errorf("Customer.ListAll yielded %d items, want 3", n)
+	}
+	if *calls != 2 {
+		t.Errorf("server received %d calls, want 2", *calls)
+	}
+}
+
+func TestIter_stopsOnErrorAndCancellation(t *testing.T) {
+	boom := errors.New("boom")
+	fetch := func(ctx context.Context, page int) ([]int, *Response, error) {
+		if page == 2 {
+			return nil, nil, boom
+		}
+		return []int{page}, &Response{NextPage: page + 1}, nil
+	}
+	var got []int
+	var gotErr error
+	for v, err := range Iter(context.Background(), 1, fetch, nil) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, v)
+	}
+	if len(got) != 1 || gotErr != boom {
+		t.Errorf("Iter yielded %v and error %v, want [1] and %v", got, gotErr, boom)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, err := range Iter(ctx, 1, fetch, nil) {
+		if err != context.Canceled {
+			t.Errorf("Iter yielded error %v, want %v", err, context.Canceled)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// PaymentRequestService handles the communication with the Payment Requests (invoices) related parts of the Paystack API
+type PaymentRequestService service
+
+type PaymentRequest struct {
+	Id               *int                     `json:"id,omitempty"`
+	Integration      *int                     `json:"integration,omitempty"`
+	Domain           *string                  `json:"domain,omitempty"`
+	Amount           *Money                   `json:"amount,omitempty"`
+	Currency         *Currency                `json:"currency,omitempty"`
+	DueDate          *time.Time               `json:"due_date,omitempty"`
+	HasInvoice       *bool                    `json:"has_invoice,omitempty"`
+	InvoiceNumber    *int                     `json:"invoice_number,omitempty"`
+	Description      *string                  `json:"description,omitempty"`
+	PdfUrl           *string                  `json:"pdf_url,omitempty"`
+	LineItems        []PaymentRequestLineItem `json:"line_items,omitempty"`
+	Tax              []PaymentRequestTax      `json:"tax,omitempty"`
+	RequestCode      *string                  `json:"request_code,omitempty"`
+	Status           *string                  `json:"status,omitempty"`
+	Paid             *bool                    `json:"paid,omitempty"`
+	PaidAt           *time.Time               `json:"paid_at,omitempty"`
+	Metadata         MetadataMap              `json:"metadata,omitempty"`
+	Notifications    []PaymentRequestNotice   `json:"notifications,omitempty"`
+	OfflineReference *string                  `json:"offline_reference,omitempty"`
+	Customer         Customer                 `json:"customer,omitempty"`
+	Archived         *bool                    `json:"archived,omitempty"`
+	CreatedAt        *time.Time               `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time               `json:"updated_at,omitempty"`
+}
+
+// PaymentRequestLineItem is an item billed on a payment request. Amount is
+// the price of one item in the minor unit.
+type PaymentRequestLineItem struct {
+	Name     *string `json:"name,omitempty"`
+	Amount   *Money  `json:"amount,omitempty"`
+	Quantity *int    `json:"quantity,omitempty"`
+}
+
+// PaymentRequestTax is a tax charged on a payment request, in the minor unit.
+type PaymentRequestTax struct {
+	Name   *string `json:"name,omitempty"`
+	Amount *Money  `json:"amount,omitempty"`
+}
+
+type PaymentRequestNotice struct {
+	SentAt  *time.Time `json:"sent_at,omitempty"`
+	Channel *string    `json:"channel,omitempty"`
+}
+
+// PaymentRequestRequest creates or updates a payment request. Customer is
+// the customer id or code, and DueDate is in the YYYY-MM-DD format. When
+// LineItems are given Amount may be left nil.
+type PaymentRequestRequest struct {
+	Customer         *string                  `json:"customer,omitempty"`
+	Amount           *Money                   `json:"amount,omitempty"`
+	Currency         *Currency                `json:"currency,omitempty"`
+	DueDate          *string                  `json:"due_date,omitempty"`
+	Description      *string                  `json:"description,omitempty"`
+	LineItems        []PaymentRequestLineItem `json:"line_items,omitempty"`
+	Tax              []PaymentRequestTax      `json:"tax,omitempty"`
+	SendNotification *bool                    `json:"send_notification,omitempty"`
+	Draft            *bool                    `json:"draft,omitempty"`
+	HasInvoice       *bool                    `json:"has_invoice,omitempty"`
+	InvoiceNumber    *int                     `json:"invoice_number,omitempty"`
+	SplitCode        *string                  `json:"split_code,omitempty"`
+}
+
+type PaymentRequestTotals struct {
+	Pending    []Money `json:"pending,omitempty"`
+	Successful []Money `json:"successful,omitempty"`
+	Total      []Money `json:"total,omitempty"`
+}
+
+// PaymentRequestOptions specifies the optional parameters to
+// PaymentRequestService.List
+type PaymentRequestOptions struct {
+	ListOptions
+
+	// Customer filters payment requests by customer id.
+	Customer string `url:"customer,omitempty"`
+
+	// Status filters payment requests by status, such as "pending" or
+	// "success".
+	Status string `url:"status,omitempty"`
+
+	// Currency filters payment requests by currency.
+	Currency Currency `url:"currency,omitempty"`
+
+	// IncludeArchive also lists archived payment requests.
+	IncludeArchive bool `url:"include_archive,omitempty"`
+
+	// From and To limit payment requests to those created in the range.
+	From time.Time `url:"from,omitempty"`
+	To   time.Time `url:"to,omitempty"`
+}
+
+// Create creates a payment request
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#create-payment-request
+func (s *PaymentRequestService) Create(ctx context.Context, pr *PaymentRequestRequest) (*PaymentRequest, *Response, error) {
+	u := fmt.Sprintf("paymentrequest")
+	req, err := s.client.NewRequest("POST", u, pr)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[PaymentRequest])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// List returns the payment requests available on the integration
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#list-payment-request
+func (s *PaymentRequestService) List(ctx context.Context, opt *PaymentRequestOptions) ([]PaymentRequest, *Response, error) {
+	u := fmt.Sprintf("paymentrequest")
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	lr := new(Envelope[[]PaymentRequest])
+	resp, err := s.client.Do(ctx, req, lr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lr.Data, resp, nil
+}
+
+// ListAll iterates over all payment requests, calling List for each page
+func (s *PaymentRequestService) ListAll(ctx context.Context, opt *PaymentRequestOptions, iopt *IterOptions) iter.Seq2[PaymentRequest, error] {
+	var o PaymentRequestOptions
+	if opt != nil {
+		o = *opt
+	}
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]PaymentRequest, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
+}
+
+// Fetch returns the details of a payment request, given its id or code
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#view-payment-request
+func (s *PaymentRequestService) Fetch(ctx context.Context, id string) (*PaymentRequest, *Response, error) {
+	u := fmt.Sprintf("paymentrequest/" + id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[PaymentRequest])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// Verify returns the details of a payment request, including whether it
+// has been paid
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#verify-payment-request
+func (s *PaymentRequestService) Verify(ctx context.Context, code string) (*PaymentRequest, *Response, error) {
+	u := fmt.Sprintf("paymentrequest/verify/" + code)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[PaymentRequest])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// SendNotification sends an email reminder of a payment request to the
+// customer
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#send-notification
+func (s *PaymentRequestService) SendNotification(ctx context.Context, code string) (*Response, error) {
+	u := fmt.Sprintf("paymentrequest/notify/" + code)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// Totals returns the amounts of pending and successful payment requests,
+// by currency
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#payment-request-total
+func (s *PaymentRequestService) Totals(ctx context.Context) (*PaymentRequestTotals, *Response, error) {
+	u := fmt.Sprintf("paymentrequest/totals")
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[PaymentRequestTotals])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// Finalize publishes a draft payment request, optionally notifying the
+// customer
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#finalize-payment-request
+func (s *PaymentRequestService) Finalize(ctx context.Context, code string, sendNotification bool) (*PaymentRequest, *Response, error) {
+	u := fmt.Sprintf("paymentrequest/finalize/" + code)
+	body := struct {
+		SendNotification bool `json:"send_notification"`
+	}{sendNotification}
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[PaymentRequest])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// Update updates a payment request, given its id or code
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#update-payment-request
+func (s *PaymentRequestService) Update(ctx context.Context, id string, pr *PaymentRequestRequest) (*PaymentRequest, *Response, error) {
+	u := fmt.Sprintf("paymentrequest/" + id)
+	req, err := s.client.NewRequest("PUT", u, pr)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[PaymentRequest])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// Archive hides a payment request from List, unless IncludeArchive is set
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#archive-payment-request
+func (s *PaymentRequestService) Archive(ctx context.Context, code string) (*Response, error) {
+	u := fmt.Sprintf("paymentrequest/archive/" + code)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
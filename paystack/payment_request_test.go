@@ -0,0 +1,195 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPaymentRequestService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/paymentrequest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		want := map[string]interface{}{
+			"customer":    "CUS_xwaj0txjryg393b",
+			"currency":    "NGN",
+			"description": "a test invoice",
+			"due_date":    "2020-07-08",
+			"line_items": []interface{}{
+				map[string]interface{}{"name": "item 1", "amount": float64(20000), "quantity": float64(2)},
+			},
+			"tax": []interface{}{
+				map[string]interface{}{"name": "VAT", "amount": float64(2000)},
+			},
+		}
+		if !cmp.Equal(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Payment request created",
+		  "data": {
+			"id": 3136406,
+			"domain": "test",
+			"amount": 42000,
+			"currency": "NGN",
+			"due_date": "2020-07-08T00:00:00.000Z",
+			"has_invoice": false,
+			"invoice_number": null,
+			"description": "a test invoice",
+			"line_items": [{"name": "item 1", "amount": 20000, "quantity": 2}],
+			"tax": [{"name": "VAT", "amount": 2000}],
+			"request_code": "PRQ_1weqqsn2wwzgft8",
+			"status": "pending",
+			"paid": false,
+			"metadata": null,
+			"notifications": [],
+			"offline_reference": "4286263136406",
+			"customer": 25833615
+		  }
+		}`)
+	})
+
+	pr, _, err := client.PaymentRequest.Create(context.Background(), &PaymentRequestRequest{
+		Customer:    String("CUS_xwaj0txjryg393b"),
+		Description: String("a test invoice"),
+		DueDate:     String("2020-07-08"),
+		LineItems:   []PaymentRequestLineItem{{Name: String("item 1"), Amount: NewMoney(20000, NGN), Quantity: Int(2)}},
+		Tax:         []PaymentRequestTax{{Name: String("VAT"), Amount: NewMoney(2000, NGN)}},
+	})
+	if err != nil {
+		t.Errorf("PaymentRequest.Create returned error: %v", err)
+	}
+
+	due := time.Date(2020, 7, 8, 0, 0, 0, 0, time.UTC)
+	want := &PaymentRequest{
+		Id:               Int(3136406),
+		Domain:           String("test"),
+		Amount:           NewMoney(42000, NGN),
+		Currency:         NGN.Ptr(),
+		DueDate:          &due,
+		HasInvoice:       Bool(false),
+		Description:      String("a test invoice"),
+		LineItems:        []PaymentRequestLineItem{{Name: String("item 1"), Amount: NewMoney(20000, NGN), Quantity: Int(2)}},
+		Tax:              []PaymentRequestTax{{Name: String("VAT"), Amount: NewMoney(2000, NGN)}},
+		RequestCode:      String("PRQ_1weqqsn2wwzgft8"),
+		Status:           String("pending"),
+		Paid:             Bool(false),
+		Notifications:    []PaymentRequestNotice{},
+		OfflineReference: String("4286263136406"),
+		Customer:         Customer{Id: Int(25833615)},
+	}
+	if !cmp.Equal(pr, want) {
+		t.Errorf("PaymentRequest.Create returned %+v, want %+v", pr, want)
+	}
+}
+
+func TestPaymentRequestService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/paymentrequest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"status": "pending", "currency": "NGN", "include_archive": "true"})
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Payment requests retrieved",
+		  "data": [{"id": 3136406, "request_code": "PRQ_1weqqsn2wwzgft8", "status": "pending"}],
+		  "meta": {"total": 1, "skipped": 0, "perPage": 50, "page": 1, "pageCount": 1}
+		}`)
+	})
+
+	prs, _, err := client.PaymentRequest.List(context.Background(), &PaymentRequestOptions{Status: "pending", Currency: "NGN", IncludeArchive: true})
+	if err != nil {
+		t.Errorf("PaymentRequest.List returned error: %v", err)
+	}
+
+	want := []PaymentRequest{{Id: Int(3136406), RequestCode: String("PRQ_1weqqsn2wwzgft8"), Status: String("pending")}}
+	if !cmp.Equal(prs, want) {
+		t.Errorf("PaymentRequest.List returned %+v, want %+v", prs, want)
+	}
+}
+
+func TestPaymentRequestService_Totals(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/paymentrequest/totals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Payment request totals",
+		  "data": {
+			"pending": [{"currency": "NGN", "amount": 42000}, {"currency": "USD", "amount": 0}],
+			"successful": [{"currency": "NGN", "amount": 0}],
+			"total": [{"currency": "NGN", "amount": 42000}]
+		  }
+		}`)
+	})
+
+	totals, _, err := client.PaymentRequest.Totals(context.Background())
+	if err != nil {
+		t.Errorf("PaymentRequest.Totals returned error: %v", err)
+	}
+
+	want := &PaymentRequestTotals{
+		Pending:    []Money{{Amount: 42000, Currency: NGN}, {Amount: 0, Currency: USD}},
+		Successful: []Money{{Amount: 0, Currency: NGN}},
+		Total:      []Money{{Amount: 42000, Currency: NGN}},
+	}
+	if !cmp.Equal(totals, want) {
+		t.Errorf("PaymentRequest.Totals returned %+v, want %+v", totals, want)
+	}
+}
+
+func TestPaymentRequestService_Finalize(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/paymentrequest/finalize/PRQ_1weqqsn2wwzgft8", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if want := map[string]interface{}{"send_notification": false}; !cmp.Equal(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Payment request finalized",
+		  "data": {"id": 3136406, "request_code": "PRQ_1weqqsn2wwzgft8", "status": "pending"}
+		}`)
+	})
+
+	pr, _, err := client.PaymentRequest.Finalize(context.Background(), "PRQ_1weqqsn2wwzgft8", false)
+	if err != nil {
+		t.Errorf("PaymentRequest.Finalize returned error: %v", err)
+	}
+
+	want := &PaymentRequest{Id: Int(3136406), RequestCode: String("PRQ_1weqqsn2wwzgft8"), Status: String("pending")}
+	if !cmp.Equal(pr, want) {
+		t.Errorf("PaymentRequest.Finalize returned %+v, want %+v", pr, want)
+	}
+}
+
+func TestPaymentRequestService_Archive(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/paymentrequest/archive/PRQ_1weqqsn2wwzgft8", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"status": true, "message": "Payment request has been archived"}`)
+	})
+
+	if _, err := client.PaymentRequest.Archive(context.Background(), "PRQ_1weqqsn2wwzgft8"); err != nil {
+		t.Errorf("PaymentRequest.Archive returned error: %v", err)
+	}
+}
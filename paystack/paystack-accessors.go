@@ -27,6 +27,54 @@ func (a *AccountData) GetAccountNumber() string {
 	return *a.AccountNumber
 }
 
+// GetAddress returns the Address field if it's non-nil, zero value otherwise.
+func (a *AddressRequest) GetAddress() string {
+	if a == nil || a.Address == nil {
+		return ""
+	}
+	return *a.Address
+}
+
+// GetCity returns the City field if it's non-nil, zero value otherwise.
+func (a *AddressRequest) GetCity() string {
+	if a == nil || a.City == nil {
+		return ""
+	}
+	return *a.City
+}
+
+// GetReference returns the Reference field if it's non-nil, zero value otherwise.
+func (a *AddressRequest) GetReference() string {
+	if a == nil || a.Reference == nil {
+		return ""
+	}
+	return *a.Reference
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (a *AddressRequest) GetState() string {
+	if a == nil || a.State == nil {
+		return ""
+	}
+	return *a.State
+}
+
+// GetZipCode returns the ZipCode field if it's non-nil, zero value otherwise.
+func (a *AddressRequest) GetZipCode() string {
+	if a == nil || a.ZipCode == nil {
+		return ""
+	}
+	return *a.ZipCode
+}
+
+// GetPaymentToken returns the PaymentToken field if it's non-nil, zero value otherwise.
+func (a *ApplePay) GetPaymentToken() string {
+	if a == nil || a.PaymentToken == nil {
+		return ""
+	}
+	return *a.PaymentToken
+}
+
 // GetAuthorizationCode returns the AuthorizationCode field if it's non-nil, zero value otherwise.
 func (a *Authorization) GetAuthorizationCode() string {
 	if a == nil || a.AuthorizationCode == nil {
@@ -124,21 +172,29 @@ func (a *Authorization) GetSignature() string {
 }
 
 // GetBalance returns the Balance field if it's non-nil, zero value otherwise.
-func (b *Balance) GetBalance() int {
+func (b *Balance) GetBalance() Money {
 	if b == nil || b.Balance == nil {
-		return 0
+		return Money{}
 	}
 	return *b.Balance
 }
 
 // GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (b *Balance) GetCurrency() string {
+func (b *Balance) GetCurrency() Currency {
 	if b == nil || b.Currency == nil {
 		return ""
 	}
 	return *b.Currency
 }
 
+// GetAccountNumber returns the AccountNumber field if it's non-nil, zero value otherwise.
+func (b *Bank) GetAccountNumber() string {
+	if b == nil || b.AccountNumber == nil {
+		return ""
+	}
+	return *b.AccountNumber
+}
+
 // GetActive returns the Active field if it's non-nil, zero value otherwise.
 func (b *Bank) GetActive() bool {
 	if b == nil || b.Active == nil {
@@ -211,6 +267,14 @@ func (b *Bank) GetUpdatedAt() time.Time {
 	return *b.UpdatedAt
 }
 
+// GetAccountExpiresAt returns the AccountExpiresAt field if it's non-nil, zero value otherwise.
+func (b *BankTransfer) GetAccountExpiresAt() time.Time {
+	if b == nil || b.AccountExpiresAt == nil {
+		return time.Time{}
+	}
+	return *b.AccountExpiresAt
+}
+
 // GetBank returns the Bank field if it's non-nil, zero value otherwise.
 func (b *Bin) GetBank() string {
 	if b == nil || b.Bank == nil {
@@ -331,6 +395,14 @@ func (b *BulkBatch) GetIntegration() int {
 	return *b.Integration
 }
 
+// GetPendingCharges returns the PendingCharges field if it's non-nil, zero value otherwise.
+func (b *BulkBatch) GetPendingCharges() int {
+	if b == nil || b.PendingCharges == nil {
+		return 0
+	}
+	return *b.PendingCharges
+}
+
 // GetStatus returns the Status field if it's non-nil, zero value otherwise.
 func (b *BulkBatch) GetStatus() string {
 	if b == nil || b.Status == nil {
@@ -339,6 +411,14 @@ func (b *BulkBatch) GetStatus() string {
 	return *b.Status
 }
 
+// GetTotalCharges returns the TotalCharges field if it's non-nil, zero value otherwise.
+func (b *BulkBatch) GetTotalCharges() int {
+	if b == nil || b.TotalCharges == nil {
+		return 0
+	}
+	return *b.TotalCharges
+}
+
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
 func (b *BulkBatch) GetUpdatedAt() time.Time {
 	if b == nil || b.UpdatedAt == nil {
@@ -348,9 +428,9 @@ func (b *BulkBatch) GetUpdatedAt() time.Time {
 }
 
 // GetAmount returns the Amount field if it's non-nil, zero value otherwise.
-func (b *BulkBatchRequest) GetAmount() int {
+func (b *BulkBatchRequest) GetAmount() Money {
 	if b == nil || b.Amount == nil {
-		return 0
+		return Money{}
 	}
 	return *b.Amount
 }
@@ -363,10 +443,18 @@ func (b *BulkBatchRequest) GetAuthorization() string {
 	return *b.Authorization
 }
 
+// GetReference returns the Reference field if it's non-nil, zero value otherwise.
+func (b *BulkBatchRequest) GetReference() string {
+	if b == nil || b.Reference == nil {
+		return ""
+	}
+	return *b.Reference
+}
+
 // GetAmount returns the Amount field if it's non-nil, zero value otherwise.
-func (b *BulkCharge) GetAmount() int {
+func (b *BulkCharge) GetAmount() Money {
 	if b == nil || b.Amount == nil {
-		return 0
+		return Money{}
 	}
 	return *b.Amount
 }
@@ -388,7 +476,7 @@ func (b *BulkCharge) GetCreatedAt() time.Time {
 }
 
 // GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (b *BulkCharge) GetCurrency() string {
+func (b *BulkCharge) GetCurrency() Currency {
 	if b == nil || b.Currency == nil {
 		return ""
 	}
@@ -419,6 +507,14 @@ func (b *BulkCharge) GetIntegration() int {
 	return *b.Integration
 }
 
+// GetReference returns the Reference field if it's non-nil, zero value otherwise.
+func (b *BulkCharge) GetReference() string {
+	if b == nil || b.Reference == nil {
+		return ""
+	}
+	return *b.Reference
+}
+
 // GetStatus returns the Status field if it's non-nil, zero value otherwise.
 func (b *BulkCharge) GetStatus() string {
 	if b == nil || b.Status == nil {
@@ -436,7 +532,7 @@ func (b *BulkCharge) GetUpdatedAt() time.Time {
 }
 
 // GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (b *BulkTransferRequest) GetCurrency() string {
+func (b *BulkTransferRequest) GetCurrency() Currency {
 	if b == nil || b.Currency == nil {
 		return ""
 	}
@@ -523,6 +619,14 @@ func (c *Card) GetNumber() string {
 	return *c.Number
 }
 
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (c *ChargeRequest) GetAmount() Money {
+	if c == nil || c.Amount == nil {
+		return Money{}
+	}
+	return *c.Amount
+}
+
 // GetAuthorizationCode returns the AuthorizationCode field if it's non-nil, zero value otherwise.
 func (c *ChargeRequest) GetAuthorizationCode() string {
 	if c == nil || c.AuthorizationCode == nil {
@@ -531,6 +635,30 @@ func (c *ChargeRequest) GetAuthorizationCode() string {
 	return *c.AuthorizationCode
 }
 
+// GetBirthday returns the Birthday field if it's non-nil, zero value otherwise.
+func (c *ChargeRequest) GetBirthday() string {
+	if c == nil || c.Birthday == nil {
+		return ""
+	}
+	return *c.Birthday
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (c *ChargeRequest) GetCurrency() Currency {
+	if c == nil || c.Currency == nil {
+		return ""
+	}
+	return *c.Currency
+}
+
+// GetDeviceID returns the DeviceID field if it's non-nil, zero value otherwise.
+func (c *ChargeRequest) GetDeviceID() string {
+	if c == nil || c.DeviceID == nil {
+		return ""
+	}
+	return *c.DeviceID
+}
+
 // GetEmail returns the Email field if it's non-nil, zero value otherwise.
 func (c *ChargeRequest) GetEmail() string {
 	if c == nil || c.Email == nil {
@@ -547,6 +675,22 @@ func (c *ChargeRequest) GetPin() string {
 	return *c.Pin
 }
 
+// GetReference returns the Reference field if it's non-nil, zero value otherwise.
+func (c *ChargeRequest) GetReference() string {
+	if c == nil || c.Reference == nil {
+		return ""
+	}
+	return *c.Reference
+}
+
+// GetSplitCode returns the SplitCode field if it's non-nil, zero value otherwise.
+func (c *ChargeRequest) GetSplitCode() string {
+	if c == nil || c.SplitCode == nil {
+		return ""
+	}
+	return *c.SplitCode
+}
+
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
 func (c *Customer) GetCreatedAt() time.Time {
 	if c == nil || c.CreatedAt == nil {
@@ -691,733 +835,2100 @@ func (c *CustomFields) GetVariableName() string {
 	return *c.VariableName
 }
 
-// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (e *ExportRequest) GetCurrency() string {
-	if e == nil || e.Currency == nil {
+// GetAccountName returns the AccountName field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccount) GetAccountName() string {
+	if d == nil || d.AccountName == nil {
 		return ""
 	}
-	return *e.Currency
+	return *d.AccountName
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (e *ExportRequest) GetFrom() time.Time {
-	if e == nil || e.From == nil {
-		return time.Time{}
+// GetAccountNumber returns the AccountNumber field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccount) GetAccountNumber() string {
+	if d == nil || d.AccountNumber == nil {
+		return ""
 	}
-	return *e.From
+	return *d.AccountNumber
 }
 
-// GetSettled returns the Settled field if it's non-nil, zero value otherwise.
-func (e *ExportRequest) GetSettled() bool {
-	if e == nil || e.Settled == nil {
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccount) GetActive() bool {
+	if d == nil || d.Active == nil {
 		return false
 	}
-	return *e.Settled
+	return *d.Active
 }
 
-// GetSettlement returns the Settlement field if it's non-nil, zero value otherwise.
-func (e *ExportRequest) GetSettlement() string {
-	if e == nil || e.Settlement == nil {
-		return ""
+// GetAssigned returns the Assigned field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccount) GetAssigned() bool {
+	if d == nil || d.Assigned == nil {
+		return false
 	}
-	return *e.Settlement
+	return *d.Assigned
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (e *ExportRequest) GetStatus() string {
-	if e == nil || e.Status == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccount) GetCreatedAt() time.Time {
+	if d == nil || d.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *d.CreatedAt
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccount) GetCurrency() Currency {
+	if d == nil || d.Currency == nil {
 		return ""
 	}
-	return *e.Status
+	return *d.Currency
 }
 
-// GetTo returns the To field if it's non-nil, zero value otherwise.
-func (e *ExportRequest) GetTo() time.Time {
-	if e == nil || e.To == nil {
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccount) GetId() int {
+	if d == nil || d.Id == nil {
+		return 0
+	}
+	return *d.Id
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccount) GetUpdatedAt() time.Time {
+	if d == nil || d.UpdatedAt == nil {
 		return time.Time{}
 	}
-	return *e.To
+	return *d.UpdatedAt
 }
 
-// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
-func (f *FieldByCurrency) GetAmount() string {
-	if f == nil || f.Amount == nil {
+// GetAccountType returns the AccountType field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountAssignment) GetAccountType() string {
+	if d == nil || d.AccountType == nil {
 		return ""
 	}
-	return *f.Amount
+	return *d.AccountType
 }
 
-// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (f *FieldByCurrency) GetCurrency() string {
-	if f == nil || f.Currency == nil {
-		return ""
+// GetAssignedAt returns the AssignedAt field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountAssignment) GetAssignedAt() time.Time {
+	if d == nil || d.AssignedAt == nil {
+		return time.Time{}
 	}
-	return *f.Currency
+	return *d.AssignedAt
 }
 
-// GetOTP returns the OTP field if it's non-nil, zero value otherwise.
-func (f *FinalizeTransferRequest) GetOTP() string {
-	if f == nil || f.OTP == nil {
-		return ""
+// GetAssigneeId returns the AssigneeId field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountAssignment) GetAssigneeId() int {
+	if d == nil || d.AssigneeId == nil {
+		return 0
 	}
-	return *f.OTP
+	return *d.AssigneeId
 }
 
-// GetTransferCode returns the TransferCode field if it's non-nil, zero value otherwise.
-func (f *FinalizeTransferRequest) GetTransferCode() string {
-	if f == nil || f.TransferCode == nil {
+// GetAssigneeType returns the AssigneeType field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountAssignment) GetAssigneeType() string {
+	if d == nil || d.AssigneeType == nil {
 		return ""
 	}
-	return *f.TransferCode
+	return *d.AssigneeType
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (h *History) GetMessage() string {
-	if h == nil || h.Message == nil {
-		return ""
+// GetExpired returns the Expired field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountAssignment) GetExpired() bool {
+	if d == nil || d.Expired == nil {
+		return false
 	}
-	return *h.Message
+	return *d.Expired
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (h *History) GetType() string {
-	if h == nil || h.Type == nil {
-		return ""
+// GetExpiredAt returns the ExpiredAt field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountAssignment) GetExpiredAt() time.Time {
+	if d == nil || d.ExpiredAt == nil {
+		return time.Time{}
 	}
-	return *h.Type
+	return *d.ExpiredAt
 }
 
-// GetTime returns the Time field if it's non-nil, zero value otherwise.
-func (i *History) GetTime() int {
-	if i == nil || i.Time == nil {
+// GetIntegration returns the Integration field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountAssignment) GetIntegration() int {
+	if d == nil || d.Integration == nil {
 		return 0
 	}
-	return *i.Time
+	return *d.Integration
 }
 
-
-// GetTimeout returns the Timeout field if it's non-nil, zero value otherwise.
-func (i *IntegrationOptions) GetTimeout() int {
-	if i == nil || i.Timeout == nil {
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountBank) GetId() int {
+	if d == nil || d.Id == nil {
 		return 0
 	}
-	return *i.Timeout
+	return *d.Id
 }
 
-// GetChannel returns the Channel field if it's non-nil, zero value otherwise.
-func (l *Log) GetChannel() string {
-	if l == nil || l.Channel == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountBank) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *l.Channel
+	return *d.Name
 }
 
-// GetMobile returns the Mobile field if it's non-nil, zero value otherwise.
-func (l *Log) GetMobile() bool {
-	if l == nil || l.Mobile == nil {
-		return false
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountBank) GetSlug() string {
+	if d == nil || d.Slug == nil {
+		return ""
 	}
-	return *l.Mobile
+	return *d.Slug
 }
 
-// GetSuccess returns the Success field if it's non-nil, zero value otherwise.
-func (l *Log) GetSuccess() bool {
-	if l == nil || l.Success == nil {
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountOptions) GetActive() bool {
+	if d == nil || d.Active == nil {
 		return false
 	}
-	return *l.Success
+	return *d.Active
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (m *Message) GetMessage() string {
-	if m == nil || m.Message == nil {
+// GetBankId returns the BankId field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountProvider) GetBankId() int {
+	if d == nil || d.BankId == nil {
+		return 0
+	}
+	return *d.BankId
+}
+
+// GetBankName returns the BankName field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountProvider) GetBankName() string {
+	if d == nil || d.BankName == nil {
 		return ""
 	}
-	return *m.Message
+	return *d.BankName
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (m *Message) GetStatus() bool {
-	if m == nil || m.Status == nil {
-		return false
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountProvider) GetId() int {
+	if d == nil || d.Id == nil {
+		return 0
 	}
-	return *m.Status
+	return *d.Id
 }
 
-// GetOTP returns the OTP field if it's non-nil, zero value otherwise.
-func (o *OTPRequest) GetOTP() string {
-	if o == nil || o.OTP == nil {
+// GetProviderSlug returns the ProviderSlug field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountProvider) GetProviderSlug() string {
+	if d == nil || d.ProviderSlug == nil {
 		return ""
 	}
-	return *o.OTP
+	return *d.ProviderSlug
 }
 
-// GetReference returns the Reference field if it's non-nil, zero value otherwise.
-func (o *OTPRequest) GetReference() string {
-	if o == nil || o.Reference == nil {
+// GetCustomer returns the Customer field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountRequest) GetCustomer() string {
+	if d == nil || d.Customer == nil {
 		return ""
 	}
-	return *o.Reference
+	return *d.Customer
 }
 
-// GetActive returns the Active field if it's non-nil, zero value otherwise.
-func (p *Page) GetActive() bool {
-	if p == nil || p.Active == nil {
-		return false
+// GetFirstName returns the FirstName field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountRequest) GetFirstName() string {
+	if d == nil || d.FirstName == nil {
+		return ""
 	}
-	return *p.Active
+	return *d.FirstName
 }
 
-// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
-func (p *Page) GetAmount() int {
-	if p == nil || p.Amount == nil {
-		return 0
+// GetLastName returns the LastName field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountRequest) GetLastName() string {
+	if d == nil || d.LastName == nil {
+		return ""
 	}
-	return *p.Amount
+	return *d.LastName
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *Page) GetCreatedAt() time.Time {
-	if p == nil || p.CreatedAt == nil {
-		return time.Time{}
+// GetPhone returns the Phone field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountRequest) GetPhone() string {
+	if d == nil || d.Phone == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *d.Phone
 }
 
-// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (p *Page) GetCurrency() string {
-	if p == nil || p.Currency == nil {
+// GetPreferredBank returns the PreferredBank field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountRequest) GetPreferredBank() string {
+	if d == nil || d.PreferredBank == nil {
 		return ""
 	}
-	return *p.Currency
+	return *d.PreferredBank
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (p *Page) GetDescription() string {
-	if p == nil || p.Description == nil {
+// GetSplitCode returns the SplitCode field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountRequest) GetSplitCode() string {
+	if d == nil || d.SplitCode == nil {
 		return ""
 	}
-	return *p.Description
+	return *d.SplitCode
 }
 
-// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
-func (p *Page) GetDomain() string {
-	if p == nil || p.Domain == nil {
+// GetSubaccount returns the Subaccount field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountRequest) GetSubaccount() string {
+	if d == nil || d.Subaccount == nil {
 		return ""
 	}
-	return *p.Domain
+	return *d.Subaccount
 }
 
-// GetId returns the Id field if it's non-nil, zero value otherwise.
-func (p *Page) GetId() int {
-	if p == nil || p.Id == nil {
-		return 0
+// GetCustomer returns the Customer field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountSplitRequest) GetCustomer() string {
+	if d == nil || d.Customer == nil {
+		return ""
 	}
-	return *p.Id
+	return *d.Customer
 }
 
-// GetIntegration returns the Integration field if it's non-nil, zero value otherwise.
-func (p *Page) GetIntegration() int {
-	if p == nil || p.Integration == nil {
-		return 0
+// GetPreferredBank returns the PreferredBank field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountSplitRequest) GetPreferredBank() string {
+	if d == nil || d.PreferredBank == nil {
+		return ""
 	}
-	return *p.Integration
+	return *d.PreferredBank
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *Page) GetName() string {
-	if p == nil || p.Name == nil {
+// GetSplitCode returns the SplitCode field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountSplitRequest) GetSplitCode() string {
+	if d == nil || d.SplitCode == nil {
 		return ""
 	}
-	return *p.Name
+	return *d.SplitCode
 }
 
-// GetPlan returns the Plan field if it's non-nil, zero value otherwise.
-func (p *Page) GetPlan() int {
-	if p == nil || p.Plan == nil {
-		return 0
+// GetSubaccount returns the Subaccount field if it's non-nil, zero value otherwise.
+func (d *DedicatedAccountSplitRequest) GetSubaccount() string {
+	if d == nil || d.Subaccount == nil {
+		return ""
 	}
-	return *p.Plan
+	return *d.Subaccount
 }
 
-// GetRedirectUrl returns the RedirectUrl field if it's non-nil, zero value otherwise.
-func (p *Page) GetRedirectUrl() string {
-	if p == nil || p.RedirectUrl == nil {
+// GetAttachments returns the Attachments field if it's non-nil, zero value otherwise.
+func (d *Dispute) GetAttachments() string {
+	if d == nil || d.Attachments == nil {
 		return ""
 	}
-	return *p.RedirectUrl
+	return *d.Attachments
 }
 
-// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
-func (p *Page) GetSlug() string {
-	if p == nil || p.Slug == nil {
+// GetBin returns the Bin field if it's non-nil, zero value otherwise.
+func (d *Dispute) GetBin() string {
+	if d == nil || d.Bin == nil {
 		return ""
 	}
-	return *p.Slug
+	return *d.Bin
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *Page) GetUpdatedAt() time.Time {
-	if p == nil || p.UpdatedAt == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *Dispute) GetCreatedAt() time.Time {
+	if d == nil || d.CreatedAt == nil {
 		return time.Time{}
 	}
-	return *p.UpdatedAt
-}
-
-// GetActive returns the Active field if it's non-nil, zero value otherwise.
-func (p *PageRequest) GetActive() bool {
-	if p == nil || p.Active == nil {
-		return false
-	}
-	return *p.Active
+	return *d.CreatedAt
 }
 
-// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
-func (p *PageRequest) GetAmount() int {
-	if p == nil || p.Amount == nil {
-		return 0
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (d *Dispute) GetCurrency() Currency {
+	if d == nil || d.Currency == nil {
+		return ""
 	}
-	return *p.Amount
+	return *d.Currency
 }
 
-// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (p *PageRequest) GetCurrency() string {
-	if p == nil || p.Currency == nil {
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (d *Dispute) GetDomain() string {
+	if d == nil || d.Domain == nil {
 		return ""
 	}
-	return *p.Currency
+	return *d.Domain
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (p *PageRequest) GetDescription() string {
-	if p == nil || p.Description == nil {
-		return ""
+// GetDueAt returns the DueAt field if it's non-nil, zero value otherwise.
+func (d *Dispute) GetDueAt() time.Time {
+	if d == nil || d.DueAt == nil {
+		return time.Time{}
 	}
-	return *p.Description
+	return *d.DueAt
 }
 
 // GetId returns the Id field if it's non-nil, zero value otherwise.
-func (p *PageRequest) GetId() int {
-	if p == nil || p.Id == nil {
+func (d *Dispute) GetId() int {
+	if d == nil || d.Id == nil {
 		return 0
 	}
-	return *p.Id
+	return *d.Id
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PageRequest) GetName() string {
-	if p == nil || p.Name == nil {
+// GetLast4 returns the Last4 field if it's non-nil, zero value otherwise.
+func (d *Dispute) GetLast4() string {
+	if d == nil || d.Last4 == nil {
 		return ""
 	}
-	return *p.Name
+	return *d.Last4
 }
 
-// GetRedirectUrl returns the RedirectUrl field if it's non-nil, zero value otherwise.
-func (p *PageRequest) GetRedirectUrl() string {
-	if p == nil || p.RedirectUrl == nil {
+// GetNote returns the Note field if it's non-nil, zero value otherwise.
+func (d *Dispute) GetNote() string {
+	if d == nil || d.Note == nil {
 		return ""
 	}
-	return *p.RedirectUrl
+	return *d.Note
 }
 
-// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
-func (p *PageRequest) GetSlug() string {
-	if p == nil || p.Slug == nil {
-		return ""
+// GetRefundAmount returns the RefundAmount field if it's non-nil, zero value otherwise.
+func (d *Dispute) GetRefundAmount() Money {
+	if d == nil || d.RefundAmount == nil {
+		return Money{}
 	}
-	return *p.Slug
+	return *d.RefundAmount
 }
 
-// GetPaymentSessionTimeout returns the PaymentSessionTimeout field if it's non-nil, zero value otherwise.
-func (p *PaymentSession) GetPaymentSessionTimeout() int {
-	if p == nil || p.PaymentSessionTimeout == nil {
-		return 0
+// GetResolvedAt returns the ResolvedAt field if it's non-nil, zero value otherwise.
+func (d *Dispute) GetResolvedAt() time.Time {
+	if d == nil || d.ResolvedAt == nil {
+		return time.Time{}
 	}
-	return *p.PaymentSessionTimeout
+	return *d.ResolvedAt
 }
 
-// GetOTP returns the OTP field if it's non-nil, zero value otherwise.
-func (p *PhoneRequest) GetOTP() string {
-	if p == nil || p.OTP == nil {
+// GetTransactionReference returns the TransactionReference field if it's non-nil, zero value otherwise.
+func (d *Dispute) GetTransactionReference() string {
+	if d == nil || d.TransactionReference == nil {
 		return ""
 	}
-	return *p.OTP
+	return *d.TransactionReference
 }
 
-// GetReference returns the Reference field if it's non-nil, zero value otherwise.
-func (p *PhoneRequest) GetReference() string {
-	if p == nil || p.Reference == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *Dispute) GetUpdatedAt() time.Time {
+	if d == nil || d.UpdatedAt == nil {
+		return time.Time{}
 	}
-	return *p.Reference
+	return *d.UpdatedAt
 }
 
-// GetPin returns the Pin field if it's non-nil, zero value otherwise.
-func (p *PinRequest) GetPin() string {
-	if p == nil || p.Pin == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidence) GetCreatedAt() time.Time {
+	if d == nil || d.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *p.Pin
+	return *d.CreatedAt
 }
 
-// GetReference returns the Reference field if it's non-nil, zero value otherwise.
-func (p *PinRequest) GetReference() string {
-	if p == nil || p.Reference == nil {
+// GetCustomerEmail returns the CustomerEmail field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidence) GetCustomerEmail() string {
+	if d == nil || d.CustomerEmail == nil {
 		return ""
 	}
-	return *p.Reference
+	return *d.CustomerEmail
 }
 
-// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
-func (p *Plan) GetAmount() int {
-	if p == nil || p.Amount == nil {
-		return 0
+// GetCustomerName returns the CustomerName field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidence) GetCustomerName() string {
+	if d == nil || d.CustomerName == nil {
+		return ""
 	}
-	return *p.Amount
+	return *d.CustomerName
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *Plan) GetCreatedAt() time.Time {
-	if p == nil || p.CreatedAt == nil {
-		return time.Time{}
+// GetCustomerPhone returns the CustomerPhone field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidence) GetCustomerPhone() string {
+	if d == nil || d.CustomerPhone == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *d.CustomerPhone
 }
 
-// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (p *Plan) GetCurrency() string {
-	if p == nil || p.Currency == nil {
+// GetDeliveryAddress returns the DeliveryAddress field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidence) GetDeliveryAddress() string {
+	if d == nil || d.DeliveryAddress == nil {
 		return ""
 	}
-	return *p.Currency
+	return *d.DeliveryAddress
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (p *Plan) GetDescription() string {
-	if p == nil || p.Description == nil {
+// GetDeliveryDate returns the DeliveryDate field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidence) GetDeliveryDate() string {
+	if d == nil || d.DeliveryDate == nil {
 		return ""
 	}
-	return *p.Description
+	return *d.DeliveryDate
 }
 
-// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
-func (p *Plan) GetDomain() string {
-	if p == nil || p.Domain == nil {
-		return ""
+// GetDispute returns the Dispute field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidence) GetDispute() int {
+	if d == nil || d.Dispute == nil {
+		return 0
 	}
-	return *p.Domain
+	return *d.Dispute
 }
 
-// GetHostedPage returns the HostedPage field if it's non-nil, zero value otherwise.
-func (p *Plan) GetHostedPage() bool {
-	if p == nil || p.HostedPage == nil {
-		return false
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidence) GetId() int {
+	if d == nil || d.Id == nil {
+		return 0
 	}
-	return *p.HostedPage
+	return *d.Id
 }
 
-// GetHostedPageSummary returns the HostedPageSummary field if it's non-nil, zero value otherwise.
-func (p *Plan) GetHostedPageSummary() string {
-	if p == nil || p.HostedPageSummary == nil {
+// GetServiceDetails returns the ServiceDetails field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidence) GetServiceDetails() string {
+	if d == nil || d.ServiceDetails == nil {
 		return ""
 	}
-	return *p.HostedPageSummary
+	return *d.ServiceDetails
 }
 
-// GetHostedPageURL returns the HostedPageURL field if it's non-nil, zero value otherwise.
-func (p *Plan) GetHostedPageURL() string {
-	if p == nil || p.HostedPageURL == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidence) GetUpdatedAt() time.Time {
+	if d == nil || d.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *d.UpdatedAt
+}
+
+// GetCustomerEmail returns the CustomerEmail field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidenceRequest) GetCustomerEmail() string {
+	if d == nil || d.CustomerEmail == nil {
 		return ""
 	}
-	return *p.HostedPageURL
+	return *d.CustomerEmail
 }
 
-// GetId returns the Id field if it's non-nil, zero value otherwise.
-func (p *Plan) GetId() int {
-	if p == nil || p.Id == nil {
-		return 0
+// GetCustomerName returns the CustomerName field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidenceRequest) GetCustomerName() string {
+	if d == nil || d.CustomerName == nil {
+		return ""
 	}
-	return *p.Id
+	return *d.CustomerName
 }
 
-// GetIntegration returns the Integration field if it's non-nil, zero value otherwise.
-func (p *Plan) GetIntegration() int {
-	if p == nil || p.Integration == nil {
-		return 0
+// GetCustomerPhone returns the CustomerPhone field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidenceRequest) GetCustomerPhone() string {
+	if d == nil || d.CustomerPhone == nil {
+		return ""
 	}
-	return *p.Integration
+	return *d.CustomerPhone
 }
 
-// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
-func (p *Plan) GetInterval() string {
-	if p == nil || p.Interval == nil {
+// GetDeliveryAddress returns the DeliveryAddress field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidenceRequest) GetDeliveryAddress() string {
+	if d == nil || d.DeliveryAddress == nil {
 		return ""
 	}
-	return *p.Interval
+	return *d.DeliveryAddress
 }
 
-// GetInvoiceLimit returns the InvoiceLimit field if it's non-nil, zero value otherwise.
-func (p *Plan) GetInvoiceLimit() string {
-	if p == nil || p.InvoiceLimit == nil {
+// GetDeliveryDate returns the DeliveryDate field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidenceRequest) GetDeliveryDate() string {
+	if d == nil || d.DeliveryDate == nil {
 		return ""
 	}
-	return *p.InvoiceLimit
+	return *d.DeliveryDate
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *Plan) GetName() string {
-	if p == nil || p.Name == nil {
+// GetServiceDetails returns the ServiceDetails field if it's non-nil, zero value otherwise.
+func (d *DisputeEvidenceRequest) GetServiceDetails() string {
+	if d == nil || d.ServiceDetails == nil {
 		return ""
 	}
-	return *p.Name
+	return *d.ServiceDetails
 }
 
-// GetPlanCode returns the PlanCode field if it's non-nil, zero value otherwise.
-func (p *Plan) GetPlanCode() string {
-	if p == nil || p.PlanCode == nil {
+// GetBy returns the By field if it's non-nil, zero value otherwise.
+func (d *DisputeHistory) GetBy() string {
+	if d == nil || d.By == nil {
 		return ""
 	}
-	return *p.PlanCode
+	return *d.By
 }
 
-// GetSendInvoices returns the SendInvoices field if it's non-nil, zero value otherwise.
-func (p *Plan) GetSendInvoices() bool {
-	if p == nil || p.SendInvoices == nil {
-		return false
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DisputeHistory) GetCreatedAt() time.Time {
+	if d == nil || d.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *p.SendInvoices
+	return *d.CreatedAt
 }
 
-// GetSendSms returns the SendSms field if it's non-nil, zero value otherwise.
-func (p *Plan) GetSendSms() bool {
-	if p == nil || p.SendSms == nil {
-		return false
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (d *DisputeMessage) GetBody() string {
+	if d == nil || d.Body == nil {
+		return ""
 	}
-	return *p.SendSms
+	return *d.Body
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *Plan) GetUpdatedAt() time.Time {
-	if p == nil || p.UpdatedAt == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DisputeMessage) GetCreatedAt() time.Time {
+	if d == nil || d.CreatedAt == nil {
 		return time.Time{}
 	}
-	return *p.UpdatedAt
+	return *d.CreatedAt
 }
 
-// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
-func (p *PlanOptions) GetInterval() string {
-	if p == nil || p.Interval == nil {
+// GetSender returns the Sender field if it's non-nil, zero value otherwise.
+func (d *DisputeMessage) GetSender() string {
+	if d == nil || d.Sender == nil {
 		return ""
 	}
-	return *p.Interval
+	return *d.Sender
 }
 
-// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
-func (p *PlanRequest) GetAmount() int {
-	if p == nil || p.Amount == nil {
+// GetEvidence returns the Evidence field if it's non-nil, zero value otherwise.
+func (d *DisputeResolveRequest) GetEvidence() int {
+	if d == nil || d.Evidence == nil {
 		return 0
 	}
-	return *p.Amount
+	return *d.Evidence
 }
 
-// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (p *PlanRequest) GetCurrency() string {
-	if p == nil || p.Currency == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (d *DisputeResolveRequest) GetMessage() string {
+	if d == nil || d.Message == nil {
 		return ""
 	}
-	return *p.Currency
+	return *d.Message
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (p *PlanRequest) GetDescription() string {
-	if p == nil || p.Description == nil {
-		return ""
+// GetRefundAmount returns the RefundAmount field if it's non-nil, zero value otherwise.
+func (d *DisputeResolveRequest) GetRefundAmount() Money {
+	if d == nil || d.RefundAmount == nil {
+		return Money{}
 	}
-	return *p.Description
+	return *d.RefundAmount
 }
 
-// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
-func (p *PlanRequest) GetInterval() string {
-	if p == nil || p.Interval == nil {
+// GetUploadedFilename returns the UploadedFilename field if it's non-nil, zero value otherwise.
+func (d *DisputeResolveRequest) GetUploadedFilename() string {
+	if d == nil || d.UploadedFilename == nil {
 		return ""
 	}
-	return *p.Interval
+	return *d.UploadedFilename
 }
 
-// GetInvoiceLimit returns the InvoiceLimit field if it's non-nil, zero value otherwise.
-func (p *PlanRequest) GetInvoiceLimit() string {
-	if p == nil || p.InvoiceLimit == nil {
-		return ""
+// GetRefundAmount returns the RefundAmount field if it's non-nil, zero value otherwise.
+func (d *DisputeUpdateRequest) GetRefundAmount() Money {
+	if d == nil || d.RefundAmount == nil {
+		return Money{}
 	}
-	return *p.InvoiceLimit
+	return *d.RefundAmount
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PlanRequest) GetName() string {
-	if p == nil || p.Name == nil {
+// GetUploadedFilename returns the UploadedFilename field if it's non-nil, zero value otherwise.
+func (d *DisputeUpdateRequest) GetUploadedFilename() string {
+	if d == nil || d.UploadedFilename == nil {
 		return ""
 	}
-	return *p.Name
+	return *d.UploadedFilename
 }
 
-// GetSendInvoices returns the SendInvoices field if it's non-nil, zero value otherwise.
-func (p *PlanRequest) GetSendInvoices() bool {
-	if p == nil || p.SendInvoices == nil {
-		return false
+// GetFileName returns the FileName field if it's non-nil, zero value otherwise.
+func (d *DisputeUploadURL) GetFileName() string {
+	if d == nil || d.FileName == nil {
+		return ""
 	}
-	return *p.SendInvoices
+	return *d.FileName
 }
 
-// GetSendSms returns the SendSms field if it's non-nil, zero value otherwise.
-func (p *PlanRequest) GetSendSms() bool {
-	if p == nil || p.SendSms == nil {
-		return false
+// GetSignedUrl returns the SignedUrl field if it's non-nil, zero value otherwise.
+func (d *DisputeUploadURL) GetSignedUrl() string {
+	if d == nil || d.SignedUrl == nil {
+		return ""
 	}
-	return *p.SendSms
+	return *d.SignedUrl
 }
 
 // GetAmount returns the Amount field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetAmount() int {
-	if p == nil || p.Amount == nil {
-		return 0
+func (e *ExportRequest) GetAmount() Money {
+	if e == nil || e.Amount == nil {
+		return Money{}
 	}
-	return *p.Amount
+	return *e.Amount
 }
 
-// GetAuthorization returns the Authorization field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetAuthorization() int {
-	if p == nil || p.Authorization == nil {
-		return 0
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (e *ExportRequest) GetCurrency() Currency {
+	if e == nil || e.Currency == nil {
+		return ""
 	}
-	return *p.Authorization
+	return *e.Currency
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetCreatedAt() time.Time {
-	if p == nil || p.CreatedAt == nil {
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (e *ExportRequest) GetFrom() time.Time {
+	if e == nil || e.From == nil {
 		return time.Time{}
 	}
-	return *p.CreatedAt
+	return *e.From
 }
 
-// GetCronExpression returns the CronExpression field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetCronExpression() string {
-	if p == nil || p.CronExpression == nil {
+// GetSettled returns the Settled field if it's non-nil, zero value otherwise.
+func (e *ExportRequest) GetSettled() bool {
+	if e == nil || e.Settled == nil {
+		return false
+	}
+	return *e.Settled
+}
+
+// GetSettlement returns the Settlement field if it's non-nil, zero value otherwise.
+func (e *ExportRequest) GetSettlement() string {
+	if e == nil || e.Settlement == nil {
 		return ""
 	}
-	return *p.CronExpression
+	return *e.Settlement
 }
 
-// GetCustomer returns the Customer field if it's non-nil, zero value otherwise.
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (e *ExportRequest) GetStatus() string {
+	if e == nil || e.Status == nil {
+		return ""
+	}
+	return *e.Status
+}
+
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (e *ExportRequest) GetTo() time.Time {
+	if e == nil || e.To == nil {
+		return time.Time{}
+	}
+	return *e.To
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (f *FieldByCurrency) GetAmount() string {
+	if f == nil || f.Amount == nil {
+		return ""
+	}
+	return *f.Amount
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (f *FieldByCurrency) GetCurrency() string {
+	if f == nil || f.Currency == nil {
+		return ""
+	}
+	return *f.Currency
+}
+
+// GetOTP returns the OTP field if it's non-nil, zero value otherwise.
+func (f *FinalizeTransferRequest) GetOTP() string {
+	if f == nil || f.OTP == nil {
+		return ""
+	}
+	return *f.OTP
+}
+
+// GetTransferCode returns the TransferCode field if it's non-nil, zero value otherwise.
+func (f *FinalizeTransferRequest) GetTransferCode() string {
+	if f == nil || f.TransferCode == nil {
+		return ""
+	}
+	return *f.TransferCode
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (h *History) GetMessage() string {
+	if h == nil || h.Message == nil {
+		return ""
+	}
+	return *h.Message
+}
+
+// GetTime returns the Time field if it's non-nil, zero value otherwise.
+func (h *History) GetTime() int {
+	if h == nil || h.Time == nil {
+		return 0
+	}
+	return *h.Time
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (h *History) GetType() string {
+	if h == nil || h.Type == nil {
+		return ""
+	}
+	return *h.Type
+}
+
+// GetTimeout returns the Timeout field if it's non-nil, zero value otherwise.
+func (i *IntegrationOptions) GetTimeout() int {
+	if i == nil || i.Timeout == nil {
+		return 0
+	}
+	return *i.Timeout
+}
+
+// GetAttempts returns the Attempts field if it's non-nil, zero value otherwise.
+func (l *Log) GetAttempts() int {
+	if l == nil || l.Attempts == nil {
+		return 0
+	}
+	return *l.Attempts
+}
+
+// GetChannel returns the Channel field if it's non-nil, zero value otherwise.
+func (l *Log) GetChannel() string {
+	if l == nil || l.Channel == nil {
+		return ""
+	}
+	return *l.Channel
+}
+
+// GetErrors returns the Errors field if it's non-nil, zero value otherwise.
+func (l *Log) GetErrors() int {
+	if l == nil || l.Errors == nil {
+		return 0
+	}
+	return *l.Errors
+}
+
+// GetMobile returns the Mobile field if it's non-nil, zero value otherwise.
+func (l *Log) GetMobile() bool {
+	if l == nil || l.Mobile == nil {
+		return false
+	}
+	return *l.Mobile
+}
+
+// GetSuccess returns the Success field if it's non-nil, zero value otherwise.
+func (l *Log) GetSuccess() bool {
+	if l == nil || l.Success == nil {
+		return false
+	}
+	return *l.Success
+}
+
+// GetTimeSpent returns the TimeSpent field if it's non-nil, zero value otherwise.
+func (l *Log) GetTimeSpent() int {
+	if l == nil || l.TimeSpent == nil {
+		return 0
+	}
+	return *l.TimeSpent
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (m *Message) GetMessage() string {
+	if m == nil || m.Message == nil {
+		return ""
+	}
+	return *m.Message
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (m *Message) GetStatus() bool {
+	if m == nil || m.Status == nil {
+		return false
+	}
+	return *m.Status
+}
+
+// GetPhone returns the Phone field if it's non-nil, zero value otherwise.
+func (m *MobileMoney) GetPhone() string {
+	if m == nil || m.Phone == nil {
+		return ""
+	}
+	return *m.Phone
+}
+
+// GetOTP returns the OTP field if it's non-nil, zero value otherwise.
+func (o *OTPRequest) GetOTP() string {
+	if o == nil || o.OTP == nil {
+		return ""
+	}
+	return *o.OTP
+}
+
+// GetReference returns the Reference field if it's non-nil, zero value otherwise.
+func (o *OTPRequest) GetReference() string {
+	if o == nil || o.Reference == nil {
+		return ""
+	}
+	return *o.Reference
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (p *Page) GetActive() bool {
+	if p == nil || p.Active == nil {
+		return false
+	}
+	return *p.Active
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (p *Page) GetAmount() Money {
+	if p == nil || p.Amount == nil {
+		return Money{}
+	}
+	return *p.Amount
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *Page) GetCreatedAt() time.Time {
+	if p == nil || p.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *p.CreatedAt
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (p *Page) GetCurrency() Currency {
+	if p == nil || p.Currency == nil {
+		return ""
+	}
+	return *p.Currency
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *Page) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (p *Page) GetDomain() string {
+	if p == nil || p.Domain == nil {
+		return ""
+	}
+	return *p.Domain
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (p *Page) GetId() int {
+	if p == nil || p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+// GetIntegration returns the Integration field if it's non-nil, zero value otherwise.
+func (p *Page) GetIntegration() int {
+	if p == nil || p.Integration == nil {
+		return 0
+	}
+	return *p.Integration
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Page) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetPlan returns the Plan field if it's non-nil, zero value otherwise.
+func (p *Page) GetPlan() int {
+	if p == nil || p.Plan == nil {
+		return 0
+	}
+	return *p.Plan
+}
+
+// GetRedirectUrl returns the RedirectUrl field if it's non-nil, zero value otherwise.
+func (p *Page) GetRedirectUrl() string {
+	if p == nil || p.RedirectUrl == nil {
+		return ""
+	}
+	return *p.RedirectUrl
+}
+
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (p *Page) GetSlug() string {
+	if p == nil || p.Slug == nil {
+		return ""
+	}
+	return *p.Slug
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *Page) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *Page) GetUpdatedAt() time.Time {
+	if p == nil || p.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (p *PageRequest) GetActive() bool {
+	if p == nil || p.Active == nil {
+		return false
+	}
+	return *p.Active
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (p *PageRequest) GetAmount() Money {
+	if p == nil || p.Amount == nil {
+		return Money{}
+	}
+	return *p.Amount
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (p *PageRequest) GetCurrency() Currency {
+	if p == nil || p.Currency == nil {
+		return ""
+	}
+	return *p.Currency
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *PageRequest) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (p *PageRequest) GetId() int {
+	if p == nil || p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PageRequest) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetRedirectUrl returns the RedirectUrl field if it's non-nil, zero value otherwise.
+func (p *PageRequest) GetRedirectUrl() string {
+	if p == nil || p.RedirectUrl == nil {
+		return ""
+	}
+	return *p.RedirectUrl
+}
+
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (p *PageRequest) GetSlug() string {
+	if p == nil || p.Slug == nil {
+		return ""
+	}
+	return *p.Slug
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PageRequest) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetAmount() Money {
+	if p == nil || p.Amount == nil {
+		return Money{}
+	}
+	return *p.Amount
+}
+
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetArchived() bool {
+	if p == nil || p.Archived == nil {
+		return false
+	}
+	return *p.Archived
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetCreatedAt() time.Time {
+	if p == nil || p.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *p.CreatedAt
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetCurrency() Currency {
+	if p == nil || p.Currency == nil {
+		return ""
+	}
+	return *p.Currency
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetDomain() string {
+	if p == nil || p.Domain == nil {
+		return ""
+	}
+	return *p.Domain
+}
+
+// GetDueDate returns the DueDate field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetDueDate() time.Time {
+	if p == nil || p.DueDate == nil {
+		return time.Time{}
+	}
+	return *p.DueDate
+}
+
+// GetHasInvoice returns the HasInvoice field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetHasInvoice() bool {
+	if p == nil || p.HasInvoice == nil {
+		return false
+	}
+	return *p.HasInvoice
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetId() int {
+	if p == nil || p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+// GetIntegration returns the Integration field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetIntegration() int {
+	if p == nil || p.Integration == nil {
+		return 0
+	}
+	return *p.Integration
+}
+
+// GetInvoiceNumber returns the InvoiceNumber field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetInvoiceNumber() int {
+	if p == nil || p.InvoiceNumber == nil {
+		return 0
+	}
+	return *p.InvoiceNumber
+}
+
+// GetOfflineReference returns the OfflineReference field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetOfflineReference() string {
+	if p == nil || p.OfflineReference == nil {
+		return ""
+	}
+	return *p.OfflineReference
+}
+
+// GetPaid returns the Paid field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetPaid() bool {
+	if p == nil || p.Paid == nil {
+		return false
+	}
+	return *p.Paid
+}
+
+// GetPaidAt returns the PaidAt field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetPaidAt() time.Time {
+	if p == nil || p.PaidAt == nil {
+		return time.Time{}
+	}
+	return *p.PaidAt
+}
+
+// GetPdfUrl returns the PdfUrl field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetPdfUrl() string {
+	if p == nil || p.PdfUrl == nil {
+		return ""
+	}
+	return *p.PdfUrl
+}
+
+// GetRequestCode returns the RequestCode field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetRequestCode() string {
+	if p == nil || p.RequestCode == nil {
+		return ""
+	}
+	return *p.RequestCode
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
+	}
+	return *p.Status
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PaymentRequest) GetUpdatedAt() time.Time {
+	if p == nil || p.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestLineItem) GetAmount() Money {
+	if p == nil || p.Amount == nil {
+		return Money{}
+	}
+	return *p.Amount
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestLineItem) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetQuantity returns the Quantity field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestLineItem) GetQuantity() int {
+	if p == nil || p.Quantity == nil {
+		return 0
+	}
+	return *p.Quantity
+}
+
+// GetChannel returns the Channel field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestNotice) GetChannel() string {
+	if p == nil || p.Channel == nil {
+		return ""
+	}
+	return *p.Channel
+}
+
+// GetSentAt returns the SentAt field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestNotice) GetSentAt() time.Time {
+	if p == nil || p.SentAt == nil {
+		return time.Time{}
+	}
+	return *p.SentAt
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestRequest) GetAmount() Money {
+	if p == nil || p.Amount == nil {
+		return Money{}
+	}
+	return *p.Amount
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestRequest) GetCurrency() Currency {
+	if p == nil || p.Currency == nil {
+		return ""
+	}
+	return *p.Currency
+}
+
+// GetCustomer returns the Customer field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestRequest) GetCustomer() string {
+	if p == nil || p.Customer == nil {
+		return ""
+	}
+	return *p.Customer
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestRequest) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestRequest) GetDraft() bool {
+	if p == nil || p.Draft == nil {
+		return false
+	}
+	return *p.Draft
+}
+
+// GetDueDate returns the DueDate field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestRequest) GetDueDate() string {
+	if p == nil || p.DueDate == nil {
+		return ""
+	}
+	return *p.DueDate
+}
+
+// GetHasInvoice returns the HasInvoice field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestRequest) GetHasInvoice() bool {
+	if p == nil || p.HasInvoice == nil {
+		return false
+	}
+	return *p.HasInvoice
+}
+
+// GetInvoiceNumber returns the InvoiceNumber field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestRequest) GetInvoiceNumber() int {
+	if p == nil || p.InvoiceNumber == nil {
+		return 0
+	}
+	return *p.InvoiceNumber
+}
+
+// GetSendNotification returns the SendNotification field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestRequest) GetSendNotification() bool {
+	if p == nil || p.SendNotification == nil {
+		return false
+	}
+	return *p.SendNotification
+}
+
+// GetSplitCode returns the SplitCode field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestRequest) GetSplitCode() string {
+	if p == nil || p.SplitCode == nil {
+		return ""
+	}
+	return *p.SplitCode
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestTax) GetAmount() Money {
+	if p == nil || p.Amount == nil {
+		return Money{}
+	}
+	return *p.Amount
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PaymentRequestTax) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetPaymentSessionTimeout returns the PaymentSessionTimeout field if it's non-nil, zero value otherwise.
+func (p *PaymentSession) GetPaymentSessionTimeout() int {
+	if p == nil || p.PaymentSessionTimeout == nil {
+		return 0
+	}
+	return *p.PaymentSessionTimeout
+}
+
+// GetPhone returns the Phone field if it's non-nil, zero value otherwise.
+func (p *PhoneRequest) GetPhone() string {
+	if p == nil || p.Phone == nil {
+		return ""
+	}
+	return *p.Phone
+}
+
+// GetReference returns the Reference field if it's non-nil, zero value otherwise.
+func (p *PhoneRequest) GetReference() string {
+	if p == nil || p.Reference == nil {
+		return ""
+	}
+	return *p.Reference
+}
+
+// GetIsPrimary returns the IsPrimary field if it's non-nil, zero value otherwise.
+func (p *Photo) GetIsPrimary() bool {
+	if p == nil || p.IsPrimary == nil {
+		return false
+	}
+	return *p.IsPrimary
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *Photo) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetTypeId returns the TypeId field if it's non-nil, zero value otherwise.
+func (p *Photo) GetTypeId() string {
+	if p == nil || p.TypeId == nil {
+		return ""
+	}
+	return *p.TypeId
+}
+
+// GetTypeName returns the TypeName field if it's non-nil, zero value otherwise.
+func (p *Photo) GetTypeName() string {
+	if p == nil || p.TypeName == nil {
+		return ""
+	}
+	return *p.TypeName
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *Photo) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetPin returns the Pin field if it's non-nil, zero value otherwise.
+func (p *PinRequest) GetPin() string {
+	if p == nil || p.Pin == nil {
+		return ""
+	}
+	return *p.Pin
+}
+
+// GetReference returns the Reference field if it's non-nil, zero value otherwise.
+func (p *PinRequest) GetReference() string {
+	if p == nil || p.Reference == nil {
+		return ""
+	}
+	return *p.Reference
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (p *Plan) GetAmount() Money {
+	if p == nil || p.Amount == nil {
+		return Money{}
+	}
+	return *p.Amount
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *Plan) GetCreatedAt() time.Time {
+	if p == nil || p.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *p.CreatedAt
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (p *Plan) GetCurrency() Currency {
+	if p == nil || p.Currency == nil {
+		return ""
+	}
+	return *p.Currency
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *Plan) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (p *Plan) GetDomain() string {
+	if p == nil || p.Domain == nil {
+		return ""
+	}
+	return *p.Domain
+}
+
+// GetHostedPage returns the HostedPage field if it's non-nil, zero value otherwise.
+func (p *Plan) GetHostedPage() bool {
+	if p == nil || p.HostedPage == nil {
+		return false
+	}
+	return *p.HostedPage
+}
+
+// GetHostedPageSummary returns the HostedPageSummary field if it's non-nil, zero value otherwise.
+func (p *Plan) GetHostedPageSummary() string {
+	if p == nil || p.HostedPageSummary == nil {
+		return ""
+	}
+	return *p.HostedPageSummary
+}
+
+// GetHostedPageURL returns the HostedPageURL field if it's non-nil, zero value otherwise.
+func (p *Plan) GetHostedPageURL() string {
+	if p == nil || p.HostedPageURL == nil {
+		return ""
+	}
+	return *p.HostedPageURL
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (p *Plan) GetId() int {
+	if p == nil || p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+// GetIntegration returns the Integration field if it's non-nil, zero value otherwise.
+func (p *Plan) GetIntegration() int {
+	if p == nil || p.Integration == nil {
+		return 0
+	}
+	return *p.Integration
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (p *Plan) GetInterval() string {
+	if p == nil || p.Interval == nil {
+		return ""
+	}
+	return *p.Interval
+}
+
+// GetInvoiceLimit returns the InvoiceLimit field if it's non-nil, zero value otherwise.
+func (p *Plan) GetInvoiceLimit() int {
+	if p == nil || p.InvoiceLimit == nil {
+		return 0
+	}
+	return *p.InvoiceLimit
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Plan) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetPlanCode returns the PlanCode field if it's non-nil, zero value otherwise.
+func (p *Plan) GetPlanCode() string {
+	if p == nil || p.PlanCode == nil {
+		return ""
+	}
+	return *p.PlanCode
+}
+
+// GetSendInvoices returns the SendInvoices field if it's non-nil, zero value otherwise.
+func (p *Plan) GetSendInvoices() bool {
+	if p == nil || p.SendInvoices == nil {
+		return false
+	}
+	return *p.SendInvoices
+}
+
+// GetSendSms returns the SendSms field if it's non-nil, zero value otherwise.
+func (p *Plan) GetSendSms() bool {
+	if p == nil || p.SendSms == nil {
+		return false
+	}
+	return *p.SendSms
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *Plan) GetUpdatedAt() time.Time {
+	if p == nil || p.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (p *PlanOptions) GetAmount() Money {
+	if p == nil || p.Amount == nil {
+		return Money{}
+	}
+	return *p.Amount
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (p *PlanOptions) GetInterval() string {
+	if p == nil || p.Interval == nil {
+		return ""
+	}
+	return *p.Interval
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (p *PlanRequest) GetAmount() Money {
+	if p == nil || p.Amount == nil {
+		return Money{}
+	}
+	return *p.Amount
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (p *PlanRequest) GetCurrency() Currency {
+	if p == nil || p.Currency == nil {
+		return ""
+	}
+	return *p.Currency
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *PlanRequest) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (p *PlanRequest) GetInterval() string {
+	if p == nil || p.Interval == nil {
+		return ""
+	}
+	return *p.Interval
+}
+
+// GetInvoiceLimit returns the InvoiceLimit field if it's non-nil, zero value otherwise.
+func (p *PlanRequest) GetInvoiceLimit() int {
+	if p == nil || p.InvoiceLimit == nil {
+		return 0
+	}
+	return *p.InvoiceLimit
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PlanRequest) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetSendInvoices returns the SendInvoices field if it's non-nil, zero value otherwise.
+func (p *PlanRequest) GetSendInvoices() bool {
+	if p == nil || p.SendInvoices == nil {
+		return false
+	}
+	return *p.SendInvoices
+}
+
+// GetSendSms returns the SendSms field if it's non-nil, zero value otherwise.
+func (p *PlanRequest) GetSendSms() bool {
+	if p == nil || p.SendSms == nil {
+		return false
+	}
+	return *p.SendSms
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetAmount() Money {
+	if p == nil || p.Amount == nil {
+		return Money{}
+	}
+	return *p.Amount
+}
+
+// GetAuthorization returns the Authorization field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetAuthorization() int {
+	if p == nil || p.Authorization == nil {
+		return 0
+	}
+	return *p.Authorization
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetCreatedAt() time.Time {
+	if p == nil || p.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *p.CreatedAt
+}
+
+// GetCronExpression returns the CronExpression field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetCronExpression() string {
+	if p == nil || p.CronExpression == nil {
+		return ""
+	}
+	return *p.CronExpression
+}
+
+// GetCustomer returns the Customer field if it's non-nil, zero value otherwise.
 func (p *PlanSubscription) GetCustomer() int {
 	if p == nil || p.Customer == nil {
 		return 0
 	}
-	return *p.Customer
+	return *p.Customer
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetDomain() string {
+	if p == nil || p.Domain == nil {
+		return ""
+	}
+	return *p.Domain
+}
+
+// GetEasyCronId returns the EasyCronId field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetEasyCronId() int {
+	if p == nil || p.EasyCronId == nil {
+		return 0
+	}
+	return *p.EasyCronId
+}
+
+// GetEmailToken returns the EmailToken field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetEmailToken() string {
+	if p == nil || p.EmailToken == nil {
+		return ""
+	}
+	return *p.EmailToken
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetId() int {
+	if p == nil || p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+// GetIntegration returns the Integration field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetIntegration() int {
+	if p == nil || p.Integration == nil {
+		return 0
+	}
+	return *p.Integration
+}
+
+// GetNextPaymentDate returns the NextPaymentDate field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetNextPaymentDate() time.Time {
+	if p == nil || p.NextPaymentDate == nil {
+		return time.Time{}
+	}
+	return *p.NextPaymentDate
+}
+
+// GetPlan returns the Plan field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetPlan() int {
+	if p == nil || p.Plan == nil {
+		return 0
+	}
+	return *p.Plan
+}
+
+// GetQuantity returns the Quantity field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetQuantity() int {
+	if p == nil || p.Quantity == nil {
+		return 0
+	}
+	return *p.Quantity
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
+	}
+	return *p.Status
+}
+
+// GetSubscriptionCode returns the SubscriptionCode field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetSubscriptionCode() string {
+	if p == nil || p.SubscriptionCode == nil {
+		return ""
+	}
+	return *p.SubscriptionCode
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PlanSubscription) GetUpdatedAt() time.Time {
+	if p == nil || p.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (p *Product) GetActive() bool {
+	if p == nil || p.Active == nil {
+		return false
+	}
+	return *p.Active
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *Product) GetCreatedAt() time.Time {
+	if p == nil || p.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *p.CreatedAt
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (p *Product) GetCurrency() Currency {
+	if p == nil || p.Currency == nil {
+		return ""
+	}
+	return *p.Currency
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *Product) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (p *Product) GetDomain() string {
+	if p == nil || p.Domain == nil {
+		return ""
+	}
+	return *p.Domain
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (p *Product) GetId() int {
+	if p == nil || p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+// GetInStock returns the InStock field if it's non-nil, zero value otherwise.
+func (p *Product) GetInStock() bool {
+	if p == nil || p.InStock == nil {
+		return false
+	}
+	return *p.InStock
+}
+
+// GetIntegration returns the Integration field if it's non-nil, zero value otherwise.
+func (p *Product) GetIntegration() int {
+	if p == nil || p.Integration == nil {
+		return 0
+	}
+	return *p.Integration
+}
+
+// GetMaximumOrderable returns the MaximumOrderable field if it's non-nil, zero value otherwise.
+func (p *Product) GetMaximumOrderable() int {
+	if p == nil || p.MaximumOrderable == nil {
+		return 0
+	}
+	return *p.MaximumOrderable
+}
+
+// GetMinimumOrderable returns the MinimumOrderable field if it's non-nil, zero value otherwise.
+func (p *Product) GetMinimumOrderable() int {
+	if p == nil || p.MinimumOrderable == nil {
+		return 0
+	}
+	return *p.MinimumOrderable
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Product) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetPrice returns the Price field if it's non-nil, zero value otherwise.
+func (p *Product) GetPrice() Money {
+	if p == nil || p.Price == nil {
+		return Money{}
+	}
+	return *p.Price
+}
+
+// GetProductCode returns the ProductCode field if it's non-nil, zero value otherwise.
+func (p *Product) GetProductCode() string {
+	if p == nil || p.ProductCode == nil {
+		return ""
+	}
+	return *p.ProductCode
+}
+
+// GetQuantity returns the Quantity field if it's non-nil, zero value otherwise.
+func (p *Product) GetQuantity() int {
+	if p == nil || p.Quantity == nil {
+		return 0
+	}
+	return *p.Quantity
+}
+
+// GetQuantitySold returns the QuantitySold field if it's non-nil, zero value otherwise.
+func (p *Product) GetQuantitySold() int {
+	if p == nil || p.QuantitySold == nil {
+		return 0
+	}
+	return *p.QuantitySold
+}
+
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (p *Product) GetSlug() string {
+	if p == nil || p.Slug == nil {
+		return ""
+	}
+	return *p.Slug
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *Product) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetUnlimited returns the Unlimited field if it's non-nil, zero value otherwise.
+func (p *Product) GetUnlimited() bool {
+	if p == nil || p.Unlimited == nil {
+		return false
+	}
+	return *p.Unlimited
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *Product) GetUpdatedAt() time.Time {
+	if p == nil || p.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (p *ProductRequest) GetCurrency() Currency {
+	if p == nil || p.Currency == nil {
+		return ""
+	}
+	return *p.Currency
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *ProductRequest) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProductRequest) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetPrice returns the Price field if it's non-nil, zero value otherwise.
+func (p *ProductRequest) GetPrice() Money {
+	if p == nil || p.Price == nil {
+		return Money{}
+	}
+	return *p.Price
+}
+
+// GetQuantity returns the Quantity field if it's non-nil, zero value otherwise.
+func (p *ProductRequest) GetQuantity() int {
+	if p == nil || p.Quantity == nil {
+		return 0
+	}
+	return *p.Quantity
+}
+
+// GetUnlimited returns the Unlimited field if it's non-nil, zero value otherwise.
+func (p *ProductRequest) GetUnlimited() bool {
+	if p == nil || p.Unlimited == nil {
+		return false
+	}
+	return *p.Unlimited
+}
+
+// GetProvider returns the Provider field if it's non-nil, zero value otherwise.
+func (q *QR) GetProvider() string {
+	if q == nil || q.Provider == nil {
+		return ""
+	}
+	return *q.Provider
+}
+
+// GetReauthorizationUrl returns the ReauthorizationUrl field if it's non-nil, zero value otherwise.
+func (r *Reauthorization) GetReauthorizationUrl() string {
+	if r == nil || r.ReauthorizationUrl == nil {
+		return ""
+	}
+	return *r.ReauthorizationUrl
+}
+
+// GetReference returns the Reference field if it's non-nil, zero value otherwise.
+func (r *Reauthorization) GetReference() string {
+	if r == nil || r.Reference == nil {
+		return ""
+	}
+	return *r.Reference
 }
 
-// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetDomain() string {
-	if p == nil || p.Domain == nil {
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (r *Refund) GetAmount() Money {
+	if r == nil || r.Amount == nil {
+		return Money{}
+	}
+	return *r.Amount
+}
+
+// GetChannel returns the Channel field if it's non-nil, zero value otherwise.
+func (r *Refund) GetChannel() string {
+	if r == nil || r.Channel == nil {
 		return ""
 	}
-	return *p.Domain
+	return *r.Channel
 }
 
-// GetEasyCronId returns the EasyCronId field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetEasyCronId() int {
-	if p == nil || p.EasyCronId == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *Refund) GetCreatedAt() time.Time {
+	if r == nil || r.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *p.EasyCronId
+	return *r.CreatedAt
 }
 
-// GetEmailToken returns the EmailToken field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetEmailToken() string {
-	if p == nil || p.EmailToken == nil {
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (r *Refund) GetCurrency() Currency {
+	if r == nil || r.Currency == nil {
 		return ""
 	}
-	return *p.EmailToken
+	return *r.Currency
 }
 
-// GetId returns the Id field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetId() int {
-	if p == nil || p.Id == nil {
-		return 0
+// GetCustomerNote returns the CustomerNote field if it's non-nil, zero value otherwise.
+func (r *Refund) GetCustomerNote() string {
+	if r == nil || r.CustomerNote == nil {
+		return ""
 	}
-	return *p.Id
+	return *r.CustomerNote
 }
 
-// GetIntegration returns the Integration field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetIntegration() int {
-	if p == nil || p.Integration == nil {
-		return 0
+// GetDeductedAmount returns the DeductedAmount field if it's non-nil, zero value otherwise.
+func (r *Refund) GetDeductedAmount() Money {
+	if r == nil || r.DeductedAmount == nil {
+		return Money{}
 	}
-	return *p.Integration
+	return *r.DeductedAmount
 }
 
-// GetNextPaymentDate returns the NextPaymentDate field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetNextPaymentDate() time.Time {
-	if p == nil || p.NextPaymentDate == nil {
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (r *Refund) GetDomain() string {
+	if r == nil || r.Domain == nil {
+		return ""
+	}
+	return *r.Domain
+}
+
+// GetExpectedAt returns the ExpectedAt field if it's non-nil, zero value otherwise.
+func (r *Refund) GetExpectedAt() time.Time {
+	if r == nil || r.ExpectedAt == nil {
 		return time.Time{}
 	}
-	return *p.NextPaymentDate
+	return *r.ExpectedAt
 }
 
-// GetPlan returns the Plan field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetPlan() int {
-	if p == nil || p.Plan == nil {
+// GetFullyDeducted returns the FullyDeducted field if it's non-nil, zero value otherwise.
+func (r *Refund) GetFullyDeducted() bool {
+	if r == nil || r.FullyDeducted == nil {
+		return false
+	}
+	return *r.FullyDeducted
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (r *Refund) GetId() int {
+	if r == nil || r.Id == nil {
 		return 0
 	}
-	return *p.Plan
+	return *r.Id
 }
 
-// GetQuantity returns the Quantity field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetQuantity() int {
-	if p == nil || p.Quantity == nil {
+// GetIntegration returns the Integration field if it's non-nil, zero value otherwise.
+func (r *Refund) GetIntegration() int {
+	if r == nil || r.Integration == nil {
 		return 0
 	}
-	return *p.Quantity
+	return *r.Integration
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetStatus() string {
-	if p == nil || p.Status == nil {
+// GetMerchantNote returns the MerchantNote field if it's non-nil, zero value otherwise.
+func (r *Refund) GetMerchantNote() string {
+	if r == nil || r.MerchantNote == nil {
 		return ""
 	}
-	return *p.Status
+	return *r.MerchantNote
 }
 
-// GetSubscriptionCode returns the SubscriptionCode field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetSubscriptionCode() string {
-	if p == nil || p.SubscriptionCode == nil {
+// GetRefundedAt returns the RefundedAt field if it's non-nil, zero value otherwise.
+func (r *Refund) GetRefundedAt() time.Time {
+	if r == nil || r.RefundedAt == nil {
+		return time.Time{}
+	}
+	return *r.RefundedAt
+}
+
+// GetRefundedBy returns the RefundedBy field if it's non-nil, zero value otherwise.
+func (r *Refund) GetRefundedBy() string {
+	if r == nil || r.RefundedBy == nil {
 		return ""
 	}
-	return *p.SubscriptionCode
+	return *r.RefundedBy
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (r *Refund) GetStatus() string {
+	if r == nil || r.Status == nil {
+		return ""
+	}
+	return *r.Status
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PlanSubscription) GetUpdatedAt() time.Time {
-	if p == nil || p.UpdatedAt == nil {
+func (r *Refund) GetUpdatedAt() time.Time {
+	if r == nil || r.UpdatedAt == nil {
 		return time.Time{}
 	}
-	return *p.UpdatedAt
+	return *r.UpdatedAt
 }
 
-// GetReauthorizationUrl returns the ReauthorizationUrl field if it's non-nil, zero value otherwise.
-func (r *Reauthorization) GetReauthorizationUrl() string {
-	if r == nil || r.ReauthorizationUrl == nil {
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (r *RefundRequest) GetAmount() Money {
+	if r == nil || r.Amount == nil {
+		return Money{}
+	}
+	return *r.Amount
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (r *RefundRequest) GetCurrency() Currency {
+	if r == nil || r.Currency == nil {
 		return ""
 	}
-	return *r.ReauthorizationUrl
+	return *r.Currency
 }
 
-// GetReference returns the Reference field if it's non-nil, zero value otherwise.
-func (r *Reauthorization) GetReference() string {
-	if r == nil || r.Reference == nil {
+// GetCustomerNote returns the CustomerNote field if it's non-nil, zero value otherwise.
+func (r *RefundRequest) GetCustomerNote() string {
+	if r == nil || r.CustomerNote == nil {
 		return ""
 	}
-	return *r.Reference
+	return *r.CustomerNote
+}
+
+// GetMerchantNote returns the MerchantNote field if it's non-nil, zero value otherwise.
+func (r *RefundRequest) GetMerchantNote() string {
+	if r == nil || r.MerchantNote == nil {
+		return ""
+	}
+	return *r.MerchantNote
+}
+
+// GetTransaction returns the Transaction field if it's non-nil, zero value otherwise.
+func (r *RefundRequest) GetTransaction() string {
+	if r == nil || r.Transaction == nil {
+		return ""
+	}
+	return *r.Transaction
 }
 
 // GetCustomerCode returns the CustomerCode field if it's non-nil, zero value otherwise.
@@ -1436,68 +2947,284 @@ func (s *Settlement) GetCreatedAt() time.Time {
 	return *s.CreatedAt
 }
 
-// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
-func (s *Settlement) GetDomain() string {
-	if s == nil || s.Domain == nil {
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (s *Settlement) GetCurrency() Currency {
+	if s == nil || s.Currency == nil {
+		return ""
+	}
+	return *s.Currency
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (s *Settlement) GetDomain() string {
+	if s == nil || s.Domain == nil {
+		return ""
+	}
+	return *s.Domain
+}
+
+// GetEffectiveAmount returns the EffectiveAmount field if it's non-nil, zero value otherwise.
+func (s *Settlement) GetEffectiveAmount() Money {
+	if s == nil || s.EffectiveAmount == nil {
+		return Money{}
+	}
+	return *s.EffectiveAmount
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (s *Settlement) GetId() int {
+	if s == nil || s.Id == nil {
+		return 0
+	}
+	return *s.Id
+}
+
+// GetIntegration returns the Integration field if it's non-nil, zero value otherwise.
+func (s *Settlement) GetIntegration() int {
+	if s == nil || s.Integration == nil {
+		return 0
+	}
+	return *s.Integration
+}
+
+// GetSettledDate returns the SettledDate field if it's non-nil, zero value otherwise.
+func (s *Settlement) GetSettledDate() time.Time {
+	if s == nil || s.SettledDate == nil {
+		return time.Time{}
+	}
+	return *s.SettledDate
+}
+
+// GetSettlementDate returns the SettlementDate field if it's non-nil, zero value otherwise.
+func (s *Settlement) GetSettlementDate() time.Time {
+	if s == nil || s.SettlementDate == nil {
+		return time.Time{}
+	}
+	return *s.SettlementDate
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (s *Settlement) GetStatus() string {
+	if s == nil || s.Status == nil {
+		return ""
+	}
+	return *s.Status
+}
+
+// GetTotalAmount returns the TotalAmount field if it's non-nil, zero value otherwise.
+func (s *Settlement) GetTotalAmount() Money {
+	if s == nil || s.TotalAmount == nil {
+		return Money{}
+	}
+	return *s.TotalAmount
+}
+
+// GetTotalFees returns the TotalFees field if it's non-nil, zero value otherwise.
+func (s *Settlement) GetTotalFees() Money {
+	if s == nil || s.TotalFees == nil {
+		return Money{}
+	}
+	return *s.TotalFees
+}
+
+// GetTotalProcessed returns the TotalProcessed field if it's non-nil, zero value otherwise.
+func (s *Settlement) GetTotalProcessed() Money {
+	if s == nil || s.TotalProcessed == nil {
+		return Money{}
+	}
+	return *s.TotalProcessed
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (s *Settlement) GetUpdatedAt() time.Time {
+	if s == nil || s.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *s.UpdatedAt
+}
+
+// GetSubaccount returns the Subaccount field if it's non-nil, zero value otherwise.
+func (s *SettlementOptions) GetSubaccount() string {
+	if s == nil || s.Subaccount == nil {
+		return ""
+	}
+	return *s.Subaccount
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (s *Split) GetActive() bool {
+	if s == nil || s.Active == nil {
+		return false
+	}
+	return *s.Active
+}
+
+// GetBearerSubaccount returns the BearerSubaccount field if it's non-nil, zero value otherwise.
+func (s *Split) GetBearerSubaccount() int {
+	if s == nil || s.BearerSubaccount == nil {
+		return 0
+	}
+	return *s.BearerSubaccount
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *Split) GetCreatedAt() time.Time {
+	if s == nil || s.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *s.CreatedAt
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (s *Split) GetCurrency() Currency {
+	if s == nil || s.Currency == nil {
+		return ""
+	}
+	return *s.Currency
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (s *Split) GetDomain() string {
+	if s == nil || s.Domain == nil {
+		return ""
+	}
+	return *s.Domain
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (s *Split) GetId() int {
+	if s == nil || s.Id == nil {
+		return 0
+	}
+	return *s.Id
+}
+
+// GetIntegration returns the Integration field if it's non-nil, zero value otherwise.
+func (s *Split) GetIntegration() int {
+	if s == nil || s.Integration == nil {
+		return 0
+	}
+	return *s.Integration
+}
+
+// GetIsDynamic returns the IsDynamic field if it's non-nil, zero value otherwise.
+func (s *Split) GetIsDynamic() bool {
+	if s == nil || s.IsDynamic == nil {
+		return false
+	}
+	return *s.IsDynamic
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *Split) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetSplitCode returns the SplitCode field if it's non-nil, zero value otherwise.
+func (s *Split) GetSplitCode() string {
+	if s == nil || s.SplitCode == nil {
+		return ""
+	}
+	return *s.SplitCode
+}
+
+// GetTotalSubaccounts returns the TotalSubaccounts field if it's non-nil, zero value otherwise.
+func (s *Split) GetTotalSubaccounts() int {
+	if s == nil || s.TotalSubaccounts == nil {
+		return 0
+	}
+	return *s.TotalSubaccounts
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (s *Split) GetUpdatedAt() time.Time {
+	if s == nil || s.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *s.UpdatedAt
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (s *SplitOptions) GetActive() bool {
+	if s == nil || s.Active == nil {
+		return false
+	}
+	return *s.Active
+}
+
+// GetBearerSubaccount returns the BearerSubaccount field if it's non-nil, zero value otherwise.
+func (s *SplitRequest) GetBearerSubaccount() string {
+	if s == nil || s.BearerSubaccount == nil {
 		return ""
 	}
-	return *s.Domain
+	return *s.BearerSubaccount
 }
 
-// GetId returns the Id field if it's non-nil, zero value otherwise.
-func (s *Settlement) GetId() int {
-	if s == nil || s.Id == nil {
-		return 0
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (s *SplitRequest) GetCurrency() Currency {
+	if s == nil || s.Currency == nil {
+		return ""
 	}
-	return *s.Id
+	return *s.Currency
 }
 
-// GetIntegration returns the Integration field if it's non-nil, zero value otherwise.
-func (s *Settlement) GetIntegration() int {
-	if s == nil || s.Integration == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *SplitRequest) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
 	}
-	return *s.Integration
+	return *s.Name
 }
 
-// GetSettledDate returns the SettledDate field if it's non-nil, zero value otherwise.
-func (s *Settlement) GetSettledDate() time.Time {
-	if s == nil || s.SettledDate == nil {
-		return time.Time{}
+// GetShare returns the Share field if it's non-nil, zero value otherwise.
+func (s *SplitShare) GetShare() int {
+	if s == nil || s.Share == nil {
+		return 0
 	}
-	return *s.SettledDate
+	return *s.Share
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (s *Settlement) GetStatus() string {
-	if s == nil || s.Status == nil {
+// GetSubaccount returns the Subaccount field if it's non-nil, zero value otherwise.
+func (s *SplitShare) GetSubaccount() string {
+	if s == nil || s.Subaccount == nil {
 		return ""
 	}
-	return *s.Status
+	return *s.Subaccount
 }
 
-// GetTotalAmount returns the TotalAmount field if it's non-nil, zero value otherwise.
-func (s *Settlement) GetTotalAmount() int {
-	if s == nil || s.TotalAmount == nil {
+// GetShare returns the Share field if it's non-nil, zero value otherwise.
+func (s *SplitSubaccount) GetShare() int {
+	if s == nil || s.Share == nil {
 		return 0
 	}
-	return *s.TotalAmount
+	return *s.Share
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (s *Settlement) GetUpdatedAt() time.Time {
-	if s == nil || s.UpdatedAt == nil {
-		return time.Time{}
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (s *SplitUpdateRequest) GetActive() bool {
+	if s == nil || s.Active == nil {
+		return false
 	}
-	return *s.UpdatedAt
+	return *s.Active
 }
 
-// GetSubaccount returns the Subaccount field if it's non-nil, zero value otherwise.
-func (s *SettlementOptions) GetSubaccount() string {
-	if s == nil || s.Subaccount == nil {
+// GetBearerSubaccount returns the BearerSubaccount field if it's non-nil, zero value otherwise.
+func (s *SplitUpdateRequest) GetBearerSubaccount() string {
+	if s == nil || s.BearerSubaccount == nil {
 		return ""
 	}
-	return *s.Subaccount
+	return *s.BearerSubaccount
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *SplitUpdateRequest) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
 }
 
 // GetAccountNumber returns the AccountNumber field if it's non-nil, zero value otherwise.
@@ -1693,9 +3420,9 @@ func (s *SubaccountRequest) GetSettlementSchedule() string {
 }
 
 // GetAmount returns the Amount field if it's non-nil, zero value otherwise.
-func (s *Subscription) GetAmount() int {
+func (s *Subscription) GetAmount() Money {
 	if s == nil || s.Amount == nil {
-		return 0
+		return Money{}
 	}
 	return *s.Amount
 }
@@ -1804,6 +3531,14 @@ func (s *Subscription) GetUpdatedAt() time.Time {
 	return *s.UpdatedAt
 }
 
+// GetPlan returns the Plan field if it's non-nil, zero value otherwise.
+func (s *SubscriptionOptions) GetPlan() int {
+	if s == nil || s.Plan == nil {
+		return 0
+	}
+	return *s.Plan
+}
+
 // GetAuthorization returns the Authorization field if it's non-nil, zero value otherwise.
 func (s *SubscriptionRequest) GetAuthorization() string {
 	if s == nil || s.Authorization == nil {
@@ -1853,9 +3588,9 @@ func (s *SubscriptionRequest) GetToken() string {
 }
 
 // GetAmount returns the Amount field if it's non-nil, zero value otherwise.
-func (s *SubscriptionResponse) GetAmount() int {
+func (s *SubscriptionResponse) GetAmount() Money {
 	if s == nil || s.Amount == nil {
-		return 0
+		return Money{}
 	}
 	return *s.Amount
 }
@@ -1956,10 +3691,34 @@ func (s *SubscriptionResponse) GetUpdatedAt() time.Time {
 	return *s.UpdatedAt
 }
 
+// GetAccountExpiresAt returns the AccountExpiresAt field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetAccountExpiresAt() time.Time {
+	if t == nil || t.AccountExpiresAt == nil {
+		return time.Time{}
+	}
+	return *t.AccountExpiresAt
+}
+
+// GetAccountName returns the AccountName field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetAccountName() string {
+	if t == nil || t.AccountName == nil {
+		return ""
+	}
+	return *t.AccountName
+}
+
+// GetAccountNumber returns the AccountNumber field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetAccountNumber() string {
+	if t == nil || t.AccountNumber == nil {
+		return ""
+	}
+	return *t.AccountNumber
+}
+
 // GetAmount returns the Amount field if it's non-nil, zero value otherwise.
-func (t *Transaction) GetAmount() int {
+func (t *Transaction) GetAmount() Money {
 	if t == nil || t.Amount == nil {
-		return 0
+		return Money{}
 	}
 	return *t.Amount
 }
@@ -1981,13 +3740,21 @@ func (t *Transaction) GetCreatedAt() time.Time {
 }
 
 // GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (t *Transaction) GetCurrency() string {
+func (t *Transaction) GetCurrency() Currency {
 	if t == nil || t.Currency == nil {
 		return ""
 	}
 	return *t.Currency
 }
 
+// GetDisplayText returns the DisplayText field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetDisplayText() string {
+	if t == nil || t.DisplayText == nil {
+		return ""
+	}
+	return *t.DisplayText
+}
+
 // GetDomain returns the Domain field if it's non-nil, zero value otherwise.
 func (t *Transaction) GetDomain() string {
 	if t == nil || t.Domain == nil {
@@ -1997,17 +3764,17 @@ func (t *Transaction) GetDomain() string {
 }
 
 // GetFees returns the Fees field if it's non-nil, zero value otherwise.
-func (t *Transaction) GetFees() int {
+func (t *Transaction) GetFees() Money {
 	if t == nil || t.Fees == nil {
-		return 0
+		return Money{}
 	}
 	return *t.Fees
 }
 
 // GetFeesSplit returns the FeesSplit field if it's non-nil, zero value otherwise.
-func (t *Transaction) GetFeesSplit() int {
+func (t *Transaction) GetFeesSplit() Money {
 	if t == nil || t.FeesSplit == nil {
-		return 0
+		return Money{}
 	}
 	return *t.FeesSplit
 }
@@ -2052,6 +3819,14 @@ func (t *Transaction) GetPaidAt() time.Time {
 	return *t.PaidAt
 }
 
+// GetQRCode returns the QRCode field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetQRCode() string {
+	if t == nil || t.QRCode == nil {
+		return ""
+	}
+	return *t.QRCode
+}
+
 // GetReference returns the Reference field if it's non-nil, zero value otherwise.
 func (t *Transaction) GetReference() string {
 	if t == nil || t.Reference == nil {
@@ -2076,8 +3851,56 @@ func (t *Transaction) GetTransactionDate() time.Time {
 	return *t.TransactionDate
 }
 
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetURL() string {
+	if t == nil || t.URL == nil {
+		return ""
+	}
+	return *t.URL
+}
+
+// GetUSSDCode returns the USSDCode field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetUSSDCode() string {
+	if t == nil || t.USSDCode == nil {
+		return ""
+	}
+	return *t.USSDCode
+}
+
+// GetAccessCode returns the AccessCode field if it's non-nil, zero value otherwise.
+func (t *TransactionAuthorization) GetAccessCode() string {
+	if t == nil || t.AccessCode == nil {
+		return ""
+	}
+	return *t.AccessCode
+}
+
+// GetAuthorizationUrl returns the AuthorizationUrl field if it's non-nil, zero value otherwise.
+func (t *TransactionAuthorization) GetAuthorizationUrl() string {
+	if t == nil || t.AuthorizationUrl == nil {
+		return ""
+	}
+	return *t.AuthorizationUrl
+}
+
+// GetReference returns the Reference field if it's non-nil, zero value otherwise.
+func (t *TransactionAuthorization) GetReference() string {
+	if t == nil || t.Reference == nil {
+		return ""
+	}
+	return *t.Reference
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (t *TransactionOptions) GetAmount() Money {
+	if t == nil || t.Amount == nil {
+		return Money{}
+	}
+	return *t.Amount
+}
+
 // GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (t *TransactionOptions) GetCurrency() string {
+func (t *TransactionOptions) GetCurrency() Currency {
 	if t == nil || t.Currency == nil {
 		return ""
 	}
@@ -2109,9 +3932,9 @@ func (t *TransactionOptions) GetSettlement() int {
 }
 
 // GetAmount returns the Amount field if it's non-nil, zero value otherwise.
-func (t *TransactionRequest) GetAmount() string {
+func (t *TransactionRequest) GetAmount() Money {
 	if t == nil || t.Amount == nil {
-		return ""
+		return Money{}
 	}
 	return *t.Amount
 }
@@ -2141,7 +3964,7 @@ func (t *TransactionRequest) GetCallbackUrl() string {
 }
 
 // GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (t *TransactionRequest) GetCurrency() string {
+func (t *TransactionRequest) GetCurrency() Currency {
 	if t == nil || t.Currency == nil {
 		return ""
 	}
@@ -2172,6 +3995,14 @@ func (t *TransactionRequest) GetReference() string {
 	return *t.Reference
 }
 
+// GetSplitCode returns the SplitCode field if it's non-nil, zero value otherwise.
+func (t *TransactionRequest) GetSplitCode() string {
+	if t == nil || t.SplitCode == nil {
+		return ""
+	}
+	return *t.SplitCode
+}
+
 // GetSubaccount returns the Subaccount field if it's non-nil, zero value otherwise.
 func (t *TransactionRequest) GetSubaccount() string {
 	if t == nil || t.Subaccount == nil {
@@ -2180,6 +4011,14 @@ func (t *TransactionRequest) GetSubaccount() string {
 	return *t.Subaccount
 }
 
+// GetTransactionCharge returns the TransactionCharge field if it's non-nil, zero value otherwise.
+func (t *TransactionRequest) GetTransactionCharge() Money {
+	if t == nil || t.TransactionCharge == nil {
+		return Money{}
+	}
+	return *t.TransactionCharge
+}
+
 // GetAttempts returns the Attempts field if it's non-nil, zero value otherwise.
 func (t *TransactionTimeline) GetAttempts() int {
 	if t == nil || t.Attempts == nil {
@@ -2237,10 +4076,138 @@ func (t *TransactionTimeline) GetTimeSpent() int {
 }
 
 // GetAmount returns the Amount field if it's non-nil, zero value otherwise.
-func (t *Transfer) GetAmount() int {
+func (t *TransactionVerify) GetAmount() Money {
 	if t == nil || t.Amount == nil {
+		return Money{}
+	}
+	return *t.Amount
+}
+
+// GetChannel returns the Channel field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetChannel() string {
+	if t == nil || t.Channel == nil {
+		return ""
+	}
+	return *t.Channel
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetCreatedAt() time.Time {
+	if t == nil || t.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *t.CreatedAt
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetCurrency() Currency {
+	if t == nil || t.Currency == nil {
+		return ""
+	}
+	return *t.Currency
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetDomain() string {
+	if t == nil || t.Domain == nil {
+		return ""
+	}
+	return *t.Domain
+}
+
+// GetFees returns the Fees field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetFees() Money {
+	if t == nil || t.Fees == nil {
+		return Money{}
+	}
+	return *t.Fees
+}
+
+// GetFeesSplit returns the FeesSplit field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetFeesSplit() Money {
+	if t == nil || t.FeesSplit == nil {
+		return Money{}
+	}
+	return *t.FeesSplit
+}
+
+// GetGatewayResponse returns the GatewayResponse field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetGatewayResponse() string {
+	if t == nil || t.GatewayResponse == nil {
+		return ""
+	}
+	return *t.GatewayResponse
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetId() int {
+	if t == nil || t.Id == nil {
 		return 0
 	}
+	return *t.Id
+}
+
+// GetIpAddress returns the IpAddress field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetIpAddress() string {
+	if t == nil || t.IpAddress == nil {
+		return ""
+	}
+	return *t.IpAddress
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetMessage() string {
+	if t == nil || t.Message == nil {
+		return ""
+	}
+	return *t.Message
+}
+
+// GetPaidAt returns the PaidAt field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetPaidAt() time.Time {
+	if t == nil || t.PaidAt == nil {
+		return time.Time{}
+	}
+	return *t.PaidAt
+}
+
+// GetPlan returns the Plan field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetPlan() string {
+	if t == nil || t.Plan == nil {
+		return ""
+	}
+	return *t.Plan
+}
+
+// GetReference returns the Reference field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetReference() string {
+	if t == nil || t.Reference == nil {
+		return ""
+	}
+	return *t.Reference
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetStatus() string {
+	if t == nil || t.Status == nil {
+		return ""
+	}
+	return *t.Status
+}
+
+// GetTransactionDate returns the TransactionDate field if it's non-nil, zero value otherwise.
+func (t *TransactionVerify) GetTransactionDate() time.Time {
+	if t == nil || t.TransactionDate == nil {
+		return time.Time{}
+	}
+	return *t.TransactionDate
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (t *Transfer) GetAmount() Money {
+	if t == nil || t.Amount == nil {
+		return Money{}
+	}
 	return *t.Amount
 }
 
@@ -2253,7 +4220,7 @@ func (t *Transfer) GetCreatedAt() time.Time {
 }
 
 // GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (t *Transfer) GetCurrency() string {
+func (t *Transfer) GetCurrency() Currency {
 	if t == nil || t.Currency == nil {
 		return ""
 	}
@@ -2292,6 +4259,14 @@ func (t *Transfer) GetReason() string {
 	return *t.Reason
 }
 
+// GetReference returns the Reference field if it's non-nil, zero value otherwise.
+func (t *Transfer) GetReference() string {
+	if t == nil || t.Reference == nil {
+		return ""
+	}
+	return *t.Reference
+}
+
 // GetSource returns the Source field if it's non-nil, zero value otherwise.
 func (t *Transfer) GetSource() string {
 	if t == nil || t.Source == nil {
@@ -2349,7 +4324,7 @@ func (t *TransferRecipient) GetCreatedAt() time.Time {
 }
 
 // GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (t *TransferRecipient) GetCurrency() string {
+func (t *TransferRecipient) GetCurrency() Currency {
 	if t == nil || t.Currency == nil {
 		return ""
 	}
@@ -2469,7 +4444,7 @@ func (t *TransferRecipientRequest) GetBankCode() string {
 }
 
 // GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (t *TransferRecipientRequest) GetCurrency() string {
+func (t *TransferRecipientRequest) GetCurrency() Currency {
 	if t == nil || t.Currency == nil {
 		return ""
 	}
@@ -2501,15 +4476,15 @@ func (t *TransferRecipientRequest) GetType() string {
 }
 
 // GetAmount returns the Amount field if it's non-nil, zero value otherwise.
-func (t *TransferRequest) GetAmount() int {
+func (t *TransferRequest) GetAmount() Money {
 	if t == nil || t.Amount == nil {
-		return 0
+		return Money{}
 	}
 	return *t.Amount
 }
 
 // GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
-func (t *TransferRequest) GetCurrency() string {
+func (t *TransferRequest) GetCurrency() Currency {
 	if t == nil || t.Currency == nil {
 		return ""
 	}
@@ -2532,6 +4507,14 @@ func (t *TransferRequest) GetRecipient() string {
 	return *t.Recipient
 }
 
+// GetReference returns the Reference field if it's non-nil, zero value otherwise.
+func (t *TransferRequest) GetReference() string {
+	if t == nil || t.Reference == nil {
+		return ""
+	}
+	return *t.Reference
+}
+
 // GetSource returns the Source field if it's non-nil, zero value otherwise.
 func (t *TransferRequest) GetSource() string {
 	if t == nil || t.Source == nil {
@@ -2547,3 +4530,11 @@ func (t *TransferRequest) GetTransferCode() string {
 	}
 	return *t.TransferCode
 }
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (u *USSD) GetType() string {
+	if u == nil || u.Type == nil {
+		return ""
+	}
+	return *u.Type
+}
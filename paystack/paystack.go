@@ -4,14 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/google/go-querystring/query"
-	"github.com/kehindesalaam/mapstructure"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -21,6 +22,8 @@ const (
 	libraryVersion = "1.0"
 	defaultBaseURL = "https://api.paystack.co/"
 	userAgent      = "go-paystack/" + libraryVersion
+
+	headerRequestID = "X-Request-Id"
 )
 
 //A Client manages communication with the Paystack API
@@ -36,6 +39,18 @@ type Client struct {
 
 	Secret string
 
+	retry *RetryPolicy // retry policy used by Do, nil disables retries.
+
+	newReference func() string // generates missing references, nil leaves them unset.
+
+	middleware []Middleware   // wraps the transport, outermost first.
+	observers  []ObserveFunc // notified of every call, see Observe.
+
+	rateMu         sync.Mutex
+	rate           Rate         // Rate limit for the client as determined by the most recent API call.
+	rateLimitReset time.Time    // Time until which requests are short-circuited, zero when the limit is not exhausted.
+	limiter        *tokenBucket // optional client side rate limiter.
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the Paystack API.
@@ -43,11 +58,17 @@ type Client struct {
 	BulkCharge        *BulkChargeService
 	Charge            *ChargeService
 	Customer          *CustomerService
+	DedicatedAccount  *DedicatedAccountService
+	Dispute           *DisputeService
 	Integration       *IntegrationService
 	Miscellaneous     *MiscellaneousService
 	Page              *PageService
+	PaymentRequest    *PaymentRequestService
 	Plan              *PlanService
+	Product           *ProductService
+	Refund            *RefundService
 	Settlement        *SettlementService
+	Split             *SplitService
 	Subaccount        *SubaccountService
 	Subscription      *SubscriptionService
 	Transaction       *TransactionService
@@ -59,7 +80,19 @@ type service struct {
 	client *Client
 }
 
+// Envelope is the standard response type of the Paystack API. Data is
+// decoded straight into T, which is the model of a single data object or a
+// slice of models for list endpoints.
+type Envelope[T any] struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    T      `json:"data"`
+	Meta    Meta   `json:"meta"`
+}
+
 //The standard response type when the Paystack API returns a single data object
+//
+// Deprecated: use Envelope, which decodes data into a typed model.
 type StandardResponse struct {
 	Status  bool                   `json:"status"`
 	Message string                 `json:"message"`
@@ -73,6 +106,8 @@ type MetaResponse struct {
 }
 
 //The standard response type when the Paystack API returns a list of data object
+//
+// Deprecated: use Envelope, which decodes data into a typed model.
 type StandardListResponse struct {
 	Status  bool                     `json:"status"`
 	Message string                   `json:"message"`
@@ -102,11 +137,20 @@ type ListOptions struct {
 	PerPage int `url:"perPage,omitempty"`
 }
 
-type BullkChargeOptions struct {
+// BulkChargeOptions filters the charges returned by
+// BulkCharge.FetchBatchCharges.
+type BulkChargeOptions struct {
 	ListOptions
-	Status string `json:"status, omitempty"`
+
+	// Status filters charges by status: "pending", "success" or "failed".
+	Status string `url:"status,omitempty"`
 }
 
+// BullkChargeOptions is the former, misspelt name of BulkChargeOptions.
+//
+// Deprecated: use BulkChargeOptions.
+type BullkChargeOptions = BulkChargeOptions
+
 type CustomerOptions struct {
 	ExcludeTransactions bool `json:"exclude_transactions, omitempty"`
 }
@@ -117,27 +161,29 @@ type IntegrationOptions struct {
 
 type TransactionOptions struct {
 	ListOptions
-	Customer    int32     `json:"customer, omitempty"`
-	Status      string    `json:"status, omitempty"`
-	From        time.Time `json:"from, omitempty"`
-	To          time.Time `json:"to, omitempty"`
-	Amount      string    `json:"amount, omitempty"`
-	Settled     *bool     `json:"settled, omitempty"`
-	PaymentPage *int      `json:"payment_page, omitempty"`
-	Currency    *string   `json:"currency, omitempty"`
-	Settlement  *int      `json:"settlement, omitempty"`
+	Customer    int32     `url:"customer,omitempty"`
+	Status      string    `url:"status,omitempty"`
+	From        time.Time `url:"from,omitempty"`
+	To          time.Time `url:"to,omitempty"`
+	Amount      *Money    `url:"amount,omitempty"`
+	Settled     *bool     `url:"settled,omitempty"`
+	PaymentPage *int      `url:"payment_page,omitempty"`
+	Currency    *Currency `url:"currency,omitempty"`
+	Settlement  *int      `url:"settlement,omitempty"`
 }
 
 type SettlementOptions struct {
-	From       time.Time `json:"from, omitempty"`
-	To         time.Time `json:"to, omitempty"`
-	Subaccount *string   `json:"subaccount, omitempty"`
+	ListOptions
+	From       time.Time `url:"from,omitempty"`
+	To         time.Time `url:"to,omitempty"`
+	Subaccount *string   `url:"subaccount,omitempty"`
+	Status     string    `url:"status,omitempty"`
 }
 
 type PlanOptions struct {
 	ListOptions
 	Interval *string `json:"interval, omitempty"`
-	Amount   string  `json:"amount, omitempty"`
+	Amount   *Money  `url:"amount,omitempty"`
 }
 
 type SubscriptionOptions struct {
@@ -187,6 +233,9 @@ type Photo struct {
 	IsPrimary *bool   `json:"isPrimary, omitempty"`
 }
 
+// FieldByCurrency is an amount in a currency, as returned in totals.
+//
+// Deprecated: totals are now decoded into Money, which accepts this form.
 type FieldByCurrency struct {
 	Currency *string `json:"currency, omitempty"`
 	Amount   *string `json:"amount, omitempty"`
@@ -197,6 +246,76 @@ type Metadata struct {
 	Photos       []Photo                  `json:"photos,omitempty"`
 }
 
+// MetadataMap holds free form metadata returned by the Paystack API, which may
+// send it as an object, as a JSON encoded object or as an unrelated scalar
+// that is ignored.
+type MetadataMap map[string]interface{}
+
+func (m *MetadataMap) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case map[string]interface{}:
+		*m = x
+	case string:
+		var o map[string]interface{}
+		if json.Unmarshal([]byte(x), &o) == nil {
+			*m = o
+		}
+	}
+	return nil
+}
+
+// enumString returns the name of the 1-based enum value v, or "" if v is
+// unset or unknown.
+func enumString(names []string, v int) string {
+	if v < 1 || v > len(names) {
+		return ""
+	}
+	return names[v-1]
+}
+
+// enumParse returns the 1-based enum value named s, or 0 if s is not one of
+// names, so that values added to the API later do not break decoding.
+func enumParse(names []string, s string) int {
+	for i, n := range names {
+		if n == s {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// enumParseStrict is enumParse for enums whose values must all be known: it
+// returns an error naming kind if s is neither "" nor one of names.
+func enumParseStrict(names []string, kind, s string) (int, error) {
+	v := enumParse(names, s)
+	if v == 0 && s != "" {
+		return 0, fmt.Errorf("paystack: unknown %s %q", kind, s)
+	}
+	return v, nil
+}
+
+// UnmarshalJSON accepts the amount either as a number or as a string.
+func (f *FieldByCurrency) UnmarshalJSON(data []byte) error {
+	var v struct {
+		Currency *string          `json:"currency"`
+		Amount   *json.RawMessage `json:"amount"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	f.Currency = v.Currency
+	f.Amount = nil
+	if v.Amount != nil && string(*v.Amount) != "null" {
+		a := strings.Trim(string(*v.Amount), `"`)
+		f.Amount = &a
+	}
+	return nil
+}
+
 // addOptions adds the parameters in opt as URL query parameters to s. opt
 // must be a struct whose fields may contain "url" tags.
 func addOptions(s string, opt interface{}) (string, error) {
@@ -246,11 +365,17 @@ func NewClient(httpClient *http.Client, options ...func(*Client)) *Client {
 	c.BulkCharge = (*BulkChargeService)(&c.common)
 	c.Charge = (*ChargeService)(&c.common)
 	c.Customer = (*CustomerService)(&c.common)
+	c.DedicatedAccount = (*DedicatedAccountService)(&c.common)
+	c.Dispute = (*DisputeService)(&c.common)
 	c.Integration = (*IntegrationService)(&c.common)
 	c.Miscellaneous = (*MiscellaneousService)(&c.common)
 	c.Page = (*PageService)(&c.common)
+	c.PaymentRequest = (*PaymentRequestService)(&c.common)
 	c.Plan = (*PlanService)(&c.common)
+	c.Product = (*ProductService)(&c.common)
+	c.Refund = (*RefundService)(&c.common)
 	c.Settlement = (*SettlementService)(&c.common)
+	c.Split = (*SplitService)(&c.common)
 	c.Subaccount = (*SubaccountService)(&c.common)
 	c.Subscription = (*SubscriptionService)(&c.common)
 	c.Transaction = (*TransactionService)(&c.common)
@@ -280,8 +405,12 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 
 	var buf io.ReadWriter
 	if body != nil {
+		body, err := withCurrency(body)
+		if err != nil {
+			return nil, err
+		}
 		buf = new(bytes.Buffer)
-		err := json.NewEncoder(buf).Encode(body)
+		err = json.NewEncoder(buf).Encode(body)
 		if err != nil {
 			return nil, err
 		}
@@ -321,12 +450,21 @@ type Response struct {
 	PrevPage  int
 	FirstPage int
 	LastPage  int
+
+	// Attempts is the number of times the request was sent, and RetryWait
+	// the total time spent waiting between those attempts.
+	Attempts  int
+	RetryWait time.Duration
+
+	// Rate is the rate limit state reported by the response headers.
+	Rate Rate
 }
 
 // newResponse creates a new Response for the provided http.Response.
 // r must not be nil.
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
+	response.Rate = parseRate(r)
 	err := response.populatePageValues()
 	if err != nil {
 		println(err.Error())
@@ -376,10 +514,69 @@ func (r *Response) populatePageValues() error {
 //
 // The provided ctx must be non-nil. If it is canceled or times out,
 // ctx.Err() will be returned.
+//
+// If the client has a RetryPolicy, failed GET, HEAD and OPTIONS requests are
+// retried with exponential backoff, honouring any Retry-After header sent by
+// Paystack, and other requests only when the policy allows it.
+// Every attempt passes through the middlewares of the client, see Use, and
+// the call as a whole is reported to its observers, see Observe.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	req = req.WithContext(ctx)
+	ctx = withOperation(ctx)
+	ctx, done := c.observe(ctx, req)
+	response, err := c.send(ctx, req.WithContext(ctx), v)
+	done(response, err)
+	return response, err
+}
+
+// send sends req until it succeeds or may not be retried, see Do.
+func (c *Client) send(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	var (
+		attempts int
+		waited   time.Duration
+	)
+	for {
+		attempts++
+		response, err := c.do(ctx, req, v)
+		if response != nil {
+			response.Attempts = attempts
+			response.RetryWait = waited
+		}
+		if err == nil || c.retry == nil || attempts > c.retry.MaxRetries ||
+			ctx.Err() != nil || !shouldRetry(response, err) || !c.retry.canRetry(ctx, req) {
+			return response, err
+		}
+
+		wait := c.retry.backoff(attempts - 1)
+		if d := retryAfter(response); d > wait {
+			wait = d
+		}
+		if !sleepCtx(ctx, wait) {
+			if ctx.Err() != nil {
+				return response, ctx.Err()
+			}
+			return response, err
+		}
+		waited += wait
+
+		if rerr := rewindBody(req); rerr != nil {
+			return response, err
+		}
+	}
+}
+
+// do sends a single attempt of req, see Do.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	// If we've hit rate limit, don't make further requests before Reset time.
+	if err := c.checkRateLimitBeforeDo(req); err != nil {
+		return &Response{Response: err.Response, Rate: err.Rate}, err
+	}
+	if c.limiter != nil {
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
 
-	resp, err := c.client.Do(req)
+	resp, err := c.roundTrip(req)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
@@ -399,6 +596,7 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	}()
 
 	response := newResponse(resp)
+	c.updateRate(response)
 	err = CheckResponse(resp)
 	if err != nil {
 		// even though there was an error, we still return the response
@@ -413,6 +611,9 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 			if err == io.EOF {
 				err = nil // ignore EOF errors caused by empty response body
 			}
+			if err == nil {
+				bindCurrency(reflect.ValueOf(v), "")
+			}
 		}
 	}
 
@@ -426,6 +627,37 @@ Paystack docs: https://developers.paystack.co/docs/errors
 type ErrorResponse struct {
 	Response *http.Response // HTTP response that caused this error
 	Message  string         `json:"message"` // error message
+
+	// Type is the class of the error, such as "validation_error" or
+	// "api_error", and Code a stable identifier of its cause, such as
+	// "insufficient_balance", when Paystack sends them.
+	Type string `json:"type"`
+	Code string `json:"code"`
+
+	// Meta holds hints on how to recover from the error.
+	Meta ErrorMeta `json:"meta"`
+
+	// Errors holds the validation errors of individual request fields.
+	Errors []FieldError `json:"-"`
+
+	// RequestID is the X-Request-Id header of the response, to quote when
+	// contacting Paystack support.
+	RequestID string `json:"-"`
+
+	// Body is the raw response body.
+	Body []byte `json:"-"`
+}
+
+// ErrorMeta holds hints sent with an API error.
+type ErrorMeta struct {
+	NextStep string `json:"nextStep"`
+}
+
+// FieldError is a validation error of a request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
 }
 
 func (r *ErrorResponse) Error() string {
@@ -434,6 +666,81 @@ func (r *ErrorResponse) Error() string {
 		r.Response.StatusCode, r.Message)
 }
 
+// Sentinel errors matched by API errors with errors.Is, so that callers can
+// branch on the cause of an error rather than on its message.
+var (
+	ErrInsufficientBalance = errors.New("paystack: insufficient balance")
+	ErrInvalidOTP          = errors.New("paystack: invalid OTP")
+	ErrDuplicateReference  = errors.New("paystack: duplicate reference")
+)
+
+// errorCauses maps the sentinel errors to the codes and, for responses
+// without a code, the message fragments Paystack reports them with.
+var errorCauses = []struct {
+	err      error
+	codes    []string
+	messages []string
+}{
+	{ErrInsufficientBalance, []string{"insufficient_balance"}, []string{"balance is not enough", "insufficient balance"}},
+	{ErrInvalidOTP, []string{"invalid_otp"}, []string{"invalid otp"}},
+	{ErrDuplicateReference, []string{"duplicate_reference"}, []string{"duplicate transaction reference", "duplicate transfer reference", "duplicate reference"}},
+}
+
+// Is reports whether r was caused by target, one of the sentinel errors
+// such as ErrInsufficientBalance.
+func (r *ErrorResponse) Is(target error) bool {
+	msg := strings.ToLower(r.Message)
+	for _, c := range errorCauses {
+		if c.err != target {
+			continue
+		}
+		for _, code := range c.codes {
+			if r.Code == code {
+				return true
+			}
+		}
+		for _, m := range c.messages {
+			if strings.Contains(msg, m) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// UnmarshalJSON also decodes the field errors, which Paystack sends either
+// as a list or as an object of lists keyed by field. Malformed field errors
+// are ignored.
+func (r *ErrorResponse) UnmarshalJSON(data []byte) error {
+	type errorResponse ErrorResponse
+	var v struct {
+		*errorResponse
+		Errors json.RawMessage `json:"errors"`
+	}
+	v.errorResponse = (*errorResponse)(r)
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	r.Errors = nil
+	var byField map[string][]FieldError
+	if json.Unmarshal(v.Errors, &byField) == nil {
+		fields := make([]string, 0, len(byField))
+		for f := range byField {
+			fields = append(fields, f)
+		}
+		sort.Strings(fields)
+		for _, f := range fields {
+			for _, e := range byField[f] {
+				e.Field = f
+				r.Errors = append(r.Errors, e)
+			}
+		}
+		return nil
+	}
+	json.Unmarshal(v.Errors, &r.Errors)
+	return nil
+}
+
 // AuthError occurs when the request was not authorized.
 // This can be triggered by passing an invalid secret key
 // in the authorization header or the lack of one.
@@ -441,18 +748,27 @@ type AuthError ErrorResponse
 
 func (r *AuthError) Error() string { return (*ErrorResponse)(r).Error() }
 
+// Unwrap returns r as an *ErrorResponse, for use with errors.Is and errors.As.
+func (r *AuthError) Unwrap() error { return (*ErrorResponse)(r) }
+
 //BadRequestError occurs when a validation or client side error occurred and
 // the request was not fulfilled.
 type BadRequestError ErrorResponse
 
 func (r *BadRequestError) Error() string { return (*ErrorResponse)(r).Error() }
 
+// Unwrap returns r as an *ErrorResponse, for use with errors.Is and errors.As.
+func (r *BadRequestError) Unwrap() error { return (*ErrorResponse)(r) }
+
 // NotFoundError occurs when the request could not be fulfilled as the
 // request resource does not exist.
 type NotFoundError ErrorResponse
 
 func (r *NotFoundError) Error() string { return (*ErrorResponse)(r).Error() }
 
+// Unwrap returns r as an *ErrorResponse, for use with errors.Is and errors.As.
+func (r *NotFoundError) Unwrap() error { return (*ErrorResponse)(r) }
+
 // ServerError Occurs when the request could not be fulfilled due to an error on
 // Paystack's end. This shouldn't happen so please report to paystack as soon as you
 // encounter any instance of this.
@@ -460,6 +776,9 @@ type ServerError ErrorResponse
 
 func (r *ServerError) Error() string { return (*ErrorResponse)(r).Error() }
 
+// Unwrap returns r as an *ErrorResponse, for use with errors.Is and errors.As.
+func (r *ServerError) Unwrap() error { return (*ErrorResponse)(r) }
+
 // CheckResponse checks the API response for errors, and returns them if
 // present. A response is considered an error if it has a status code outside
 // the 200 range.
@@ -470,8 +789,11 @@ func (r *ServerError) Error() string { return (*ErrorResponse)(r).Error() }
 // The error types are listed as follows,
 // *ServerError for status code between 500 and 504 inclusive,
 // *BadRequestError for 400 status codes
-// *NotFoundError for 404 status codes
+// *NotFoundError for 404 status codes,
+// *RateLimitError for 429 status codes
 // and *AuthError for authentication errors.
+// They all unwrap to the *ErrorResponse holding the decoded body, which
+// matches sentinel errors such as ErrInsufficientBalance with errors.Is.
 func CheckResponse(r *http.Response) error {
 
 	if c := r.StatusCode; 200 <= c && c <= 299 {
@@ -482,7 +804,9 @@ func CheckResponse(r *http.Response) error {
 	data, err := ioutil.ReadAll(r.Body)
 	if err == nil && data != nil {
 		json.Unmarshal(data, errorResponse)
+		errorResponse.Body = data
 	}
+	errorResponse.RequestID = r.Header.Get(headerRequestID)
 
 	if c := r.StatusCode; 500 <= c && c <= 504 {
 		return (*ServerError)(errorResponse)
@@ -498,11 +822,46 @@ func CheckResponse(r *http.Response) error {
 	case r.StatusCode == http.StatusUnauthorized:
 		return (*AuthError)(errorResponse)
 
+	case r.StatusCode == http.StatusTooManyRequests:
+		return &RateLimitError{
+			Rate:          parseRate(r),
+			Response:      errorResponse.Response,
+			Message:       errorResponse.Message,
+			ErrorResponse: errorResponse,
+		}
+
 	default:
 		return errorResponse
 	}
 }
 
+// unmarshalRef decodes data into v, unless data is a bare id or code sent by
+// the Paystack API in place of the nested object, in which case it is passed
+// to id or code instead.
+func unmarshalRef(data []byte, v interface{}, id func(int), code func(string)) error {
+	switch {
+	case len(data) == 0:
+		return nil
+	case data[0] == '"':
+		var c string
+		if err := json.Unmarshal(data, &c); err != nil {
+			return err
+		}
+		if c != "" {
+			code(c)
+		}
+		return nil
+	case data[0] == '-' || '0' <= data[0] && data[0] <= '9':
+		var i int
+		if err := json.Unmarshal(data, &i); err != nil {
+			return err
+		}
+		id(i)
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
 // Bool is a helper routine that allocates a new bool value
 // to store v and returns a pointer to it.
 func Bool(v bool) *bool { return &v }
@@ -514,34 +873,3 @@ func Int(v int) *int { return &v }
 // String is a helper routine that allocates a new string value
 // to store v and returns a pointer to it.
 func String(v string) *string { return &v }
-
-// mapDecoder is a helper function that decodes map[string]interface{}
-// objects into another interface
-func mapDecoder(source interface{}, target interface{}) error {
-	stringToDateTimeHook := func(
-		f reflect.Type,
-		t reflect.Type,
-		data interface{}) (interface{}, error) {
-		if t == reflect.TypeOf(time.Time{}) && f == reflect.TypeOf("") {
-			return time.Parse(time.RFC3339, data.(string))
-		}
-
-		return data, nil
-	}
-
-	config := mapstructure.DecoderConfig{
-		DecodeHook: stringToDateTimeHook,
-		Result:     target,
-	}
-
-	decoder, err := mapstructure.NewDecoder(&config)
-	if err != nil {
-		return err
-	}
-
-	err = decoder.Decode(source)
-	if err != nil {
-		return err
-	}
-	return nil
-}
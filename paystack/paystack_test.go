@@ -1,11 +1,23 @@
 package paystack
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/mapstructure"
 )
 
 var (
@@ -56,3 +68,184 @@ func testFormValues(t *testing.T, r *http.Request, values values) {
 		t.Errorf("Request parameters: %v, want %v", got, want)
 	}
 }
+
+var benchCustomerJSON = []byte(`{"status":true,"message":"Customer retrieved","data":{"transactions":[],"subscriptions":[],"authorizations":[],"first_name":"Dom","last_name":"Sam","email":"dom@gmail.com","phone":null,"metadata":{"photos":[{"type":"twitter","typeId":"twitter","typeName":"Twitter","url":"https://d2ojpxxtu63wzl.cloudfront.net/static/61b1a0a1d4dda2c9fe9e165fed07f812","isPrimary":false}]},"domain":"test","customer_code":"CUS_dze0ssuhmv9u57o","id":90758908,"integration":100082,"createdAt":"2016-03-29T20:03:09.000Z","updatedAt":"2016-03-29T20:03:10.000Z"}}`)
+
+// BenchmarkEnvelope_decode measures decoding a response straight into its model.
+func BenchmarkEnvelope_decode(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r := new(Envelope[Customer])
+		if err := json.NewDecoder(bytes.NewReader(benchCustomerJSON)).Decode(r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkMapDecoder_decode measures the previous decoding of responses,
+// into a map first and then from the map into the model with mapstructure,
+// for comparison with BenchmarkEnvelope_decode.
+func BenchmarkMapDecoder_decode(b *testing.B) {
+	timeHook := func(f reflect.Type, t reflect.Type, data interface{}) (interface{}, error) {
+		if t == reflect.TypeOf(time.Time{}) && f == reflect.TypeOf("") {
+			return time.Parse(time.RFC3339, data.(string))
+		}
+		return data, nil
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var r struct {
+			Status  bool                   `json:"status"`
+			Message string                 `json:"message"`
+			Data    map[string]interface{} `json:"data"`
+		}
+		if err := json.NewDecoder(bytes.NewReader(benchCustomerJSON)).Decode(&r); err != nil {
+			b.Fatal(err)
+		}
+		m := new(Customer)
+		decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+			DecodeHook:       timeHook,
+			TagName:          "json",
+			WeaklyTypedInput: true,
+			Result:           m,
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := decoder.Decode(r.Data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestDo_decodeError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/balance", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": true, "message": "Balances retrieved", "data": [{"currency": "NGN", "balance": "lots"}]}`)
+	})
+
+	_, resp, err := client.Balance.Check(context.Background())
+	if _, ok := err.(*json.UnmarshalTypeError); !ok {
+		t.Errorf("Balance.Check returned error %#v, want *json.UnmarshalTypeError", err)
+	}
+	if resp == nil {
+		t.Error("Balance.Check returned nil response with decode error")
+	}
+}
+
+func TestUnmarshal_nestedReferences(t *testing.T) {
+	var tr Transfer
+	err := json.Unmarshal([]byte(`{"recipient": 28, "amount": 100}`), &tr)
+	if err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if tr.Recipient.GetId() != 28 {
+		t.Errorf("Transfer.Recipient.Id = %d, want 28", tr.Recipient.GetId())
+	}
+
+	var tx Transaction
+	err = json.Unmarshal([]byte(`{"plan": "PLN_gx2wn530m0i3w3m", "customer": {"id": 3}, "metadata": "{\"cart_id\": 7}"}`), &tx)
+	if err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if tx.Plan.GetPlanCode() != "PLN_gx2wn530m0i3w3m" || tx.Customer.GetId() != 3 || tx.Metadata["cart_id"] != 7.0 {
+		t.Errorf("json.Unmarshal decoded %+v", tx)
+	}
+
+	var f FieldByCurrency
+	if err := json.Unmarshal([]byte(`{"currency": "NGN", "amount": 6817200}`), &f); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if f.GetAmount() != "6817200" {
+		t.Errorf("FieldByCurrency.Amount = %q, want %q", f.GetAmount(), "6817200")
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	body := `{
+	  "status": false,
+	  "message": "Your balance is not enough to fulfil this request",
+	  "meta": {"nextStep": "Top up your balance and retry the transfer"},
+	  "type": "api_error",
+	  "code": "insufficient_balance",
+	  "errors": {"amount": [{"rule": "max", "message": "Amount is above your balance"}]}
+	}`
+	res := &http.Response{
+		Request:    &http.Request{Method: "POST", URL: &url.URL{Path: "/transfer"}},
+		StatusCode: http.StatusBadRequest,
+		Header:     http.Header{"X-Request-Id": {"req_9x8q"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+
+	err := CheckResponse(res)
+	var br *BadRequestError
+	if !errors.As(err, &br) {
+		t.Fatalf("CheckResponse returned %#v, want *BadRequestError", err)
+	}
+	var er *ErrorResponse
+	if !errors.As(err, &er) {
+		t.Fatalf("CheckResponse returned %#v, want it to unwrap to *ErrorResponse", err)
+	}
+	want := &ErrorResponse{
+		Response:  res,
+		Message:   "Your balance is not enough to fulfil this request",
+		Type:      "api_error",
+		Code:      "insufficient_balance",
+		Meta:      ErrorMeta{NextStep: "Top up your balance and retry the transfer"},
+		Errors:    []FieldError{{Field: "amount", Rule: "max", Message: "Amount is above your balance"}},
+		RequestID: "req_9x8q",
+		Body:      []byte(body),
+	}
+	if !cmp.Equal(er, want, cmpopts.IgnoreFields(ErrorResponse{}, "Response")) {
+		t.Errorf("CheckResponse returned %+v, want %+v", er, want)
+	}
+	if !errors.Is(err, ErrInsufficientBalance) || errors.Is(err, ErrInvalidOTP) {
+		t.Errorf("errors.Is(%v) does not match only ErrInsufficientBalance", err)
+	}
+}
+
+func TestErrorResponse_Is(t *testing.T) {
+	tests := []struct {
+		e    ErrorResponse
+		want error
+	}{
+		{ErrorResponse{Code: "invalid_otp"}, ErrInvalidOTP},
+		{ErrorResponse{Message: "Invalid OTP"}, ErrInvalidOTP},
+		{ErrorResponse{Message: "Duplicate Transaction Reference"}, ErrDuplicateReference},
+		{ErrorResponse{Code: "duplicate_reference"}, ErrDuplicateReference},
+		{ErrorResponse{Message: "Your balance is not enough to fulfil this request"}, ErrInsufficientBalance},
+	}
+	for _, tt := range tests {
+		e := tt.e
+		if !errors.Is(&e, tt.want) {
+			t.Errorf("errors.Is(%+v, %v) = false, want true", tt.e, tt.want)
+		}
+	}
+
+	e := &NotFoundError{Message: "Transaction not found"}
+	for _, err := range []error{ErrInsufficientBalance, ErrInvalidOTP, ErrDuplicateReference} {
+		if errors.Is(e, err) {
+			t.Errorf("errors.Is(%+v, %v) = true, want false", e, err)
+		}
+	}
+}
+
+func TestErrorResponse_fieldErrorList(t *testing.T) {
+	var e ErrorResponse
+	err := json.Unmarshal([]byte(`{"message": "Invalid data", "errors": [{"field": "email", "rule": "required", "message": "Email is required"}]}`), &e)
+	if err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	want := []FieldError{{Field: "email", Rule: "required", Message: "Email is required"}}
+	if e.Message != "Invalid data" || !cmp.Equal(e.Errors, want) {
+		t.Errorf("json.Unmarshal decoded %+v, want errors %+v", e, want)
+	}
+
+	// Field errors in an unexpected shape do not hide the message.
+	err = json.Unmarshal([]byte(`{"message": "Invalid data", "errors": "email"}`), &e)
+	if err != nil || e.Message != "Invalid data" || e.Errors != nil {
+		t.Errorf("json.Unmarshal decoded %+v, %v", e, err)
+	}
+}
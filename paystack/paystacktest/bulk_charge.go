@@ -0,0 +1,129 @@
+package paystacktest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/kehindesalaam/go-paystack/paystack"
+)
+
+// findBatch returns the bulk charge batch with the given id or code.
+func (s *Server) findBatch(key string) *paystack.BulkBatch {
+	for _, b := range s.batches {
+		if strconv.Itoa(b.GetId()) == key || b.GetBatchCode() == key {
+			return b
+		}
+	}
+	return nil
+}
+
+// findCustomerByAuthorization returns the customer owning the
+// authorization with the given code and the authorization itself.
+func (s *Server) findCustomerByAuthorization(code string) (*paystack.Customer, *paystack.Authorization) {
+	for _, c := range s.customers {
+		if a := findAuthorization(c, code); a != nil {
+			return c, a
+		}
+	}
+	return nil, nil
+}
+
+// initiateBulkCharge charges every authorization of the batch right away,
+// so that the batch is complete by the time it is returned. Charges on
+// unknown or non-reusable authorizations fail.
+func (s *Server) initiateBulkCharge(w http.ResponseWriter, r *http.Request) {
+	var req []struct {
+		Authorization string                 `json:"authorization"`
+		Amount        amount                 `json:"amount"`
+		Reference     string                 `json:"reference"`
+		Metadata      map[string]interface{} `json:"metadata"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req) == 0 {
+		writeError(w, http.StatusBadRequest, "No charges specified")
+		return
+	}
+	b := &paystack.BulkBatch{
+		Domain:         paystack.String("test"),
+		BatchCode:      paystack.String(newCode("BCH_")),
+		Status:         paystack.String("complete"),
+		Id:             paystack.Int(s.nextID()),
+		Integration:    paystack.Int(100032),
+		CreatedAt:      now(),
+		UpdatedAt:      now(),
+		TotalCharges:   paystack.Int(len(req)),
+		PendingCharges: paystack.Int(0),
+	}
+	var charges []*paystack.BulkCharge
+	for _, item := range req {
+		ch := &paystack.BulkCharge{
+			Integration: b.Integration,
+			Bulkcharge:  b.Id,
+			Domain:      b.Domain,
+			Amount:      paystack.NewMoney(int64(item.Amount), paystack.NGN),
+			Currency:    paystack.NGN.Ptr(),
+			Status:      paystack.String("failed"),
+			Metadata:    item.Metadata,
+			Id:          paystack.Int(s.nextID()),
+			CreatedAt:   now(),
+			UpdatedAt:   now(),
+		}
+		if item.Reference != "" {
+			ch.Reference = paystack.String(item.Reference)
+		}
+		c, auth := s.findCustomerByAuthorization(item.Authorization)
+		if c != nil {
+			ch.Customer = *c
+			ch.Customer.Authorizations = nil
+			ch.Authorization = *auth
+			if auth.GetReusable() && item.Amount > 0 {
+				t, err := s.newTransaction(&transactionRequest{
+					Email:     c.GetEmail(),
+					Amount:    item.Amount,
+					Reference: item.Reference,
+					Metadata:  item.Metadata,
+				}, "abandoned")
+				if err == nil {
+					s.succeed(t, *auth)
+					ch.Transaction = *t
+					ch.Status = paystack.String("success")
+				}
+			}
+		}
+		charges = append(charges, ch)
+	}
+	s.batches = append(s.batches, b)
+	s.bulkCharges[b.GetBatchCode()] = charges
+	writeData(w, "Charges have been queued", b)
+}
+
+func (s *Server) listBatches(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, "Bulk charges retrieved", s.batches)
+}
+
+func (s *Server) fetchBatch(w http.ResponseWriter, r *http.Request) {
+	b := s.findBatch(r.PathValue("code"))
+	if b == nil {
+		writeError(w, http.StatusNotFound, "Bulk charge batch not found")
+		return
+	}
+	writeData(w, "Bulk charge retrieved", b)
+}
+
+func (s *Server) fetchBatchCharges(w http.ResponseWriter, r *http.Request) {
+	b := s.findBatch(r.PathValue("code"))
+	if b == nil {
+		writeError(w, http.StatusNotFound, "Bulk charge batch not found")
+		return
+	}
+	var charges []*paystack.BulkCharge
+	for _, ch := range s.bulkCharges[b.GetBatchCode()] {
+		if v := r.URL.Query().Get("status"); v != "" && v != ch.GetStatus() {
+			continue
+		}
+		charges = append(charges, ch)
+	}
+	writeList(w, r, "Bulk charge items retrieved", charges)
+}
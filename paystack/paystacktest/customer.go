@@ -0,0 +1,173 @@
+package paystacktest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kehindesalaam/go-paystack/paystack"
+)
+
+type customerRequest struct {
+	Email     *string           `json:"email"`
+	FirstName *string           `json:"first_name"`
+	LastName  *string           `json:"last_name"`
+	Phone     *string           `json:"phone"`
+	Metadata  paystack.Metadata `json:"metadata"`
+}
+
+// findCustomer returns the customer with the given id, code or email.
+func (s *Server) findCustomer(key string) *paystack.Customer {
+	for _, c := range s.customers {
+		if strconv.Itoa(c.GetId()) == key || c.GetCustomerCode() == key || strings.EqualFold(c.GetEmail(), key) {
+			return c
+		}
+	}
+	return nil
+}
+
+// customerView returns a copy of c with its transactions and subscriptions.
+func (s *Server) customerView(c *paystack.Customer) *paystack.Customer {
+	v := *c
+	v.Transactions = []paystack.Transaction{}
+	for _, t := range s.transactions {
+		if t.Customer.GetId() == c.GetId() {
+			v.Transactions = append(v.Transactions, *t)
+		}
+	}
+	v.Subscriptions = []paystack.Subscription{}
+	for _, sub := range s.subscriptions {
+		if sub.Customer.GetId() == c.GetId() {
+			v.Subscriptions = append(v.Subscriptions, *sub)
+		}
+	}
+	v.Authorizations = append([]paystack.Authorization{}, c.Authorizations...)
+	return &v
+}
+
+// customerFor returns the customer with the given email, creating it if it
+// does not exist yet.
+func (s *Server) customerFor(email string) *paystack.Customer {
+	if c := s.findCustomer(email); c != nil {
+		return c
+	}
+	c := &paystack.Customer{
+		Email:        paystack.String(email),
+		Id:           paystack.Int(s.nextID()),
+		CustomerCode: paystack.String(newCode("CUS_")),
+		Domain:       paystack.String("test"),
+		Integration:  paystack.Int(100032),
+		CreatedAt:    now(),
+		UpdatedAt:    now(),
+	}
+	s.customers = append(s.customers, c)
+	return c
+}
+
+func (s *Server) createCustomer(w http.ResponseWriter, r *http.Request) {
+	var req customerRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Email == nil || *req.Email == "" {
+		writeError(w, http.StatusBadRequest, "Email is required")
+		return
+	}
+	c := s.customerFor(*req.Email)
+	if req.FirstName != nil {
+		c.FirstName = req.FirstName
+	}
+	if req.LastName != nil {
+		c.LastName = req.LastName
+	}
+	if req.Phone != nil {
+		c.Phone = req.Phone
+	}
+	c.Metadata = req.Metadata
+	writeData(w, "Customer created", c)
+}
+
+func (s *Server) listCustomers(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, "Customers retrieved", s.customers)
+}
+
+func (s *Server) fetchCustomer(w http.ResponseWriter, r *http.Request) {
+	c := s.findCustomer(r.PathValue("code"))
+	if c == nil {
+		writeError(w, http.StatusNotFound, "Customer not found")
+		return
+	}
+	writeData(w, "Customer retrieved", s.customerView(c))
+}
+
+func (s *Server) updateCustomer(w http.ResponseWriter, r *http.Request) {
+	c := s.findCustomer(r.PathValue("code"))
+	if c == nil {
+		writeError(w, http.StatusNotFound, "Customer not found")
+		return
+	}
+	var req customerRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.FirstName != nil {
+		c.FirstName = req.FirstName
+	}
+	if req.LastName != nil {
+		c.LastName = req.LastName
+	}
+	if req.Phone != nil {
+		c.Phone = req.Phone
+	}
+	if req.Metadata.CustomFields != nil || req.Metadata.Photos != nil {
+		c.Metadata = req.Metadata
+	}
+	c.UpdatedAt = now()
+	writeData(w, "Customer updated", c)
+}
+
+func (s *Server) setRiskAction(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Customer   ref         `json:"customer"`
+		RiskAction interface{} `json:"risk_action"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	c := s.findCustomer(string(req.Customer))
+	if c == nil {
+		writeError(w, http.StatusNotFound, "Customer not found")
+		return
+	}
+	action := "default"
+	switch v := req.RiskAction.(type) {
+	case string:
+		action = v
+	case float64:
+		if v >= 1 && int(v) <= 2 {
+			action = paystack.RiskAction(v).String()
+		}
+	}
+	c.RiskAction = paystack.String(action)
+	c.UpdatedAt = now()
+	writeData(w, "Customer updated", c)
+}
+
+func (s *Server) deactivateAuthorization(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		AuthorizationCode string `json:"authorization_code"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	for _, c := range s.customers {
+		for i, a := range c.Authorizations {
+			if a.GetAuthorizationCode() == req.AuthorizationCode {
+				c.Authorizations = append(c.Authorizations[:i:i], c.Authorizations[i+1:]...)
+				writeJSON(w, http.StatusOK, envelope{Status: true, Message: "Authorization has been deactivated"})
+				return
+			}
+		}
+	}
+	writeError(w, http.StatusNotFound, "Authorization code not found")
+}
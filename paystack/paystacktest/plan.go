@@ -0,0 +1,126 @@
+package paystacktest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/kehindesalaam/go-paystack/paystack"
+)
+
+type planRequest struct {
+	Name         *string            `json:"name"`
+	Description  *string            `json:"description"`
+	Amount       *amount            `json:"amount"`
+	Interval     *string            `json:"interval"`
+	SendInvoices *bool              `json:"send_invoices"`
+	SendSms      *bool              `json:"send_sms"`
+	Currency     *paystack.Currency `json:"currency"`
+	InvoiceLimit *int               `json:"invoice_limit"`
+}
+
+var planIntervals = map[string]bool{
+	"hourly": true, "daily": true, "weekly": true, "monthly": true,
+	"quarterly": true, "biannually": true, "annually": true,
+}
+
+// findPlan returns the plan with the given id or code.
+func (s *Server) findPlan(key string) *paystack.Plan {
+	for _, p := range s.plans {
+		if strconv.Itoa(p.GetId()) == key || p.GetPlanCode() == key {
+			return p
+		}
+	}
+	return nil
+}
+
+func (s *Server) createPlan(w http.ResponseWriter, r *http.Request) {
+	var req planRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name == nil || req.Amount == nil || req.Interval == nil {
+		writeError(w, http.StatusBadRequest, "Name, amount and interval are required")
+		return
+	}
+	if !planIntervals[*req.Interval] {
+		writeError(w, http.StatusBadRequest, "Invalid interval")
+		return
+	}
+	p := &paystack.Plan{
+		Name:         req.Name,
+		Description:  req.Description,
+		Interval:     req.Interval,
+		SendInvoices: paystack.Bool(req.SendInvoices == nil || *req.SendInvoices),
+		SendSms:      paystack.Bool(req.SendSms == nil || *req.SendSms),
+		HostedPage:   paystack.Bool(false),
+		Currency:     paystack.NGN.Ptr(),
+		InvoiceLimit: paystack.Int(0),
+		PlanCode:     paystack.String(newCode("PLN_")),
+		Domain:       paystack.String("test"),
+		Integration:  paystack.Int(100032),
+		Id:           paystack.Int(s.nextID()),
+		CreatedAt:    now(),
+		UpdatedAt:    now(),
+	}
+	if req.Currency != nil {
+		p.Currency = req.Currency
+	}
+	p.Amount = paystack.NewMoney(int64(*req.Amount), p.GetCurrency())
+	if req.InvoiceLimit != nil {
+		p.InvoiceLimit = req.InvoiceLimit
+	}
+	s.plans = append(s.plans, p)
+	writeData(w, "Plan created", p)
+}
+
+func (s *Server) listPlans(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, "Plans retrieved", s.plans)
+}
+
+func (s *Server) fetchPlan(w http.ResponseWriter, r *http.Request) {
+	p := s.findPlan(r.PathValue("code"))
+	if p == nil {
+		writeError(w, http.StatusNotFound, "Plan not found")
+		return
+	}
+	writeData(w, "Plan retrieved", p)
+}
+
+func (s *Server) updatePlan(w http.ResponseWriter, r *http.Request) {
+	p := s.findPlan(r.PathValue("code"))
+	if p == nil {
+		writeError(w, http.StatusNotFound, "Plan not found")
+		return
+	}
+	var req planRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Interval != nil && !planIntervals[*req.Interval] {
+		writeError(w, http.StatusBadRequest, "Invalid interval")
+		return
+	}
+	if req.Name != nil {
+		p.Name = req.Name
+	}
+	if req.Description != nil {
+		p.Description = req.Description
+	}
+	if req.Interval != nil {
+		p.Interval = req.Interval
+	}
+	if req.SendInvoices != nil {
+		p.SendInvoices = req.SendInvoices
+	}
+	if req.SendSms != nil {
+		p.SendSms = req.SendSms
+	}
+	if req.Currency != nil {
+		p.Currency = req.Currency
+	}
+	if req.Amount != nil {
+		p.Amount = paystack.NewMoney(int64(*req.Amount), p.GetCurrency())
+	}
+	p.UpdatedAt = now()
+	writeJSON(w, http.StatusOK, envelope{Status: true, Message: "Plan updated. 0 subscription(s) affected"})
+}
@@ -0,0 +1,413 @@
+// Copyright 2017 The go-paystack AUTHORS. All rights reserved.
+
+// Package paystacktest provides an in-process fake of the Paystack API for
+// testing code built on the paystack package.
+//
+// The fake keeps its state in memory, so that a customer created with
+// Customer.Create can be fetched with Customer.Fetch, a transaction paid
+// with PayTransaction verifies as successful, and so on. It covers
+// customers, plans, subscriptions, transactions, transfers with OTP
+// finalization, transfer recipients, balances, bulk charges and transaction exports.
+//
+//	srv := paystacktest.NewServer()
+//	defer srv.Close()
+//	client := srv.Client()
+//	cust, _, err := client.Customer.Create(ctx, &paystack.CustomerRequest{Email: paystack.String("a@b.c")})
+//
+// Failures can be injected per route with Fail, using either the route
+// pattern, such as "GET /customer/{code}", or a concrete request path, such
+// as "GET /customer/CUS_xnxdt6s1zg1f4nx".
+package paystacktest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kehindesalaam/go-paystack/paystack"
+)
+
+// DefaultSecretKey is the secret key accepted by a new Server.
+const DefaultSecretKey = "sk_test_paystacktest"
+
+// DefaultTransferOTP is the OTP accepted by a new Server when finalizing
+// transfers.
+const DefaultTransferOTP = "123456"
+
+// Failure describes a failure injected on a route.
+type Failure struct {
+	// Status is the status code to reply with, such as 500 or 429. When
+	// zero, the request is handled normally after Delay.
+	Status int
+
+	// RetryAfter sets the Retry-After header of the reply.
+	RetryAfter time.Duration
+
+	// Delay holds the reply back, or until the client gives up, to
+	// simulate slow responses and timeouts.
+	Delay time.Duration
+
+	// Times is the number of requests the failure applies to. Zero means
+	// every request until ClearFailures is called.
+	Times int
+
+	// Lost handles the request before replying with Status, as when a
+	// response is lost after Paystack processed the request.
+	Lost bool
+}
+
+// Server is a fake Paystack API server.
+type Server struct {
+	// URL is the base URL of the server, with a trailing slash.
+	URL string
+
+	// SecretKey is the secret key clients must authenticate with.
+	SecretKey string
+
+	// TransferOTP is the OTP accepted when finalizing transfers.
+	TransferOTP string
+
+	srv *httptest.Server
+	mux *http.ServeMux
+
+	mu            sync.Mutex
+	lastID        int
+	failures      map[string]*Failure
+	customers     []*paystack.Customer
+	plans         []*paystack.Plan
+	subscriptions []*paystack.Subscription
+	transactions  []*paystack.Transaction
+	recipients    []*paystack.TransferRecipient
+	transfers     []*paystack.Transfer
+	balances      map[paystack.Currency]int64
+	transferOTP   bool
+	batches       []*paystack.BulkBatch
+	bulkCharges   map[string][]*paystack.BulkCharge
+	exports       map[string][]byte
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		SecretKey:   DefaultSecretKey,
+		TransferOTP: DefaultTransferOTP,
+		mux:         http.NewServeMux(),
+		failures:    make(map[string]*Failure),
+		balances:    make(map[paystack.Currency]int64),
+		transferOTP: true,
+		bulkCharges: make(map[string][]*paystack.BulkCharge),
+		exports:     make(map[string][]byte),
+	}
+	s.routes()
+	s.srv = httptest.NewServer(s.mux)
+	s.URL = s.srv.URL + "/"
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a paystack.Client configured to talk to the server. The
+// options are applied after the secret key is set.
+func (s *Server) Client(options ...func(*paystack.Client)) *paystack.Client {
+	options = append([]func(*paystack.Client){paystack.SecretKey(s.SecretKey)}, options...)
+	c := paystack.NewClient(s.srv.Client(), options...)
+	c.BaseURL, _ = url.Parse(s.URL)
+	return c
+}
+
+// Fail injects f on route, which is either a route pattern such as
+// "POST /transfer" or "GET /customer/{code}", or a method followed by a
+// concrete request path. It replaces any failure previously set on route.
+func (s *Server) Fail(route string, f Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[route] = &f
+}
+
+// ClearFailures removes every injected failure.
+func (s *Server) ClearFailures() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = make(map[string]*Failure)
+}
+
+func (s *Server) routes() {
+	s.handle("GET /balance", s.checkBalance)
+
+	s.handle("POST /customer", s.createCustomer)
+	s.handle("GET /customer", s.listCustomers)
+	s.handle("GET /customer/{code}", s.fetchCustomer)
+	s.handle("PUT /customer/{code}", s.updateCustomer)
+	s.handle("POST /customer/set_risk_action", s.setRiskAction)
+	s.handle("POST /customer/deactivate_authorization", s.deactivateAuthorization)
+
+	s.handle("POST /plan", s.createPlan)
+	s.handle("GET /plan", s.listPlans)
+	s.handle("GET /plan/{code}", s.fetchPlan)
+	s.handle("PUT /plan/{code}", s.updatePlan)
+
+	s.handle("POST /subscription", s.createSubscription)
+	s.handle("GET /subscription", s.listSubscriptions)
+	s.handle("GET /subscription/{code}", s.fetchSubscription)
+	s.handle("POST /subscription/disable", s.disableSubscription)
+	s.handle("POST /subscription/enable", s.enableSubscription)
+
+	s.handle("POST /transaction/initialize", s.initializeTransaction)
+	s.handle("GET /transaction/verify/{reference}", s.verifyTransaction)
+	s.handle("POST /transaction/charge_authorization", s.chargeAuthorization)
+	s.handle("GET /transaction", s.listTransactions)
+	s.handle("GET /transaction/{id}", s.fetchTransaction)
+	s.handle("GET /transaction/export", s.exportTransactions)
+
+	// Exports are downloaded from signed URLs, without the secret key.
+	s.mux.HandleFunc("GET /exports/{name}", func(w http.ResponseWriter, r *http.Request) {
+		if s.inject(w, r, s.downloadExport) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.downloadExport(w, r)
+	})
+
+	s.handle("POST /transferrecipient", s.createRecipient)
+	s.handle("GET /transferrecipient", s.listRecipients)
+
+	s.handle("POST /transfer", s.initiateTransfer)
+	s.handle("POST /transfer/bulk", s.initiateBulkTransfer)
+	s.handle("GET /transfer", s.listTransfers)
+	s.handle("GET /transfer/{code}", s.fetchTransfer)
+	s.handle("GET /transfer/verify/{reference}", s.verifyTransfer)
+	s.handle("POST /transfer/finalize_transfer", s.finalizeTransfer)
+	s.handle("POST /transfer/enable_otp", s.enableTransferOTP)
+	s.handle("POST /transfer/disable_otp", s.disableTransferOTP)
+	s.handle("POST /transfer/disable_otp_finalize", s.disableTransferOTPFinalize)
+
+	s.handle("POST /bulkcharge", s.initiateBulkCharge)
+	s.handle("GET /bulkcharge", s.listBatches)
+	s.handle("GET /bulkcharge/{code}", s.fetchBatch)
+	s.handle("GET /bulkcharge/{code}/charges", s.fetchBatchCharges)
+}
+
+// handle registers h for pattern, wrapping it with failure injection,
+// authentication and locking of the server state.
+func (s *Server) handle(pattern string, h http.HandlerFunc) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		if s.inject(w, r, h) {
+			return
+		}
+		s.serve(w, r, h)
+	})
+}
+
+// serve authenticates r and passes it to h with the server state locked.
+func (s *Server) serve(w http.ResponseWriter, r *http.Request, h http.HandlerFunc) {
+	if r.Header.Get("Authorization") != "Bearer "+s.SecretKey {
+		writeError(w, http.StatusUnauthorized, "Invalid key")
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	h(w, r)
+}
+
+// inject applies the failure set on the route of r, if any, and reports
+// whether the request has been answered. Lost failures pass r to h first.
+func (s *Server) inject(w http.ResponseWriter, r *http.Request, h http.HandlerFunc) bool {
+	s.mu.Lock()
+	key := r.Pattern
+	f, ok := s.failures[key]
+	if !ok {
+		key = r.Method + " " + r.URL.Path
+		f, ok = s.failures[key]
+	}
+	var cur Failure
+	if ok {
+		cur = *f
+		if f.Times > 0 {
+			if f.Times--; f.Times == 0 {
+				delete(s.failures, key)
+			}
+		}
+	}
+	s.mu.Unlock()
+	if !ok {
+		return false
+	}
+
+	if cur.Delay > 0 {
+		t := time.NewTimer(cur.Delay)
+		defer t.Stop()
+		select {
+		case <-r.Context().Done():
+			return true
+		case <-t.C:
+		}
+	}
+	if cur.Status == 0 {
+		return false
+	}
+	if cur.Lost {
+		s.serve(httptest.NewRecorder(), r, h)
+	}
+	if cur.RetryAfter > 0 {
+		secs := int((cur.RetryAfter + time.Second - 1) / time.Second)
+		w.Header().Set("Retry-After", strconv.Itoa(secs))
+	}
+	writeError(w, cur.Status, http.StatusText(cur.Status))
+	return true
+}
+
+// envelope is the standard response of the Paystack API.
+type envelope struct {
+	Status  bool        `json:"status"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+	Meta    *meta       `json:"meta,omitempty"`
+}
+
+type meta struct {
+	Total     int `json:"total"`
+	Skipped   int `json:"skipped"`
+	PerPage   int `json:"perPage"`
+	Page      int `json:"page"`
+	PageCount int `json:"pageCount"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeData(w http.ResponseWriter, message string, data interface{}) {
+	writeJSON(w, http.StatusOK, envelope{Status: true, Message: message, Data: data})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, envelope{Status: false, Message: message})
+}
+
+// writeList writes the page of items requested by the page and perPage
+// query parameters of r. items must be a slice.
+func writeList[T any](w http.ResponseWriter, r *http.Request, message string, items []T) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("perPage"))
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		perPage = 50
+	}
+	m := &meta{Total: len(items), PerPage: perPage, Page: page}
+	m.PageCount = (len(items) + perPage - 1) / perPage
+	m.Skipped = (page - 1) * perPage
+	start, end := m.Skipped, m.Skipped+perPage
+	if start > len(items) {
+		start = len(items)
+	}
+	if end > len(items) {
+		end = len(items)
+	}
+	writeJSON(w, http.StatusOK, envelope{Status: true, Message: message, Data: items[start:end], Meta: m})
+}
+
+// decode decodes the JSON body of r into v, answering with a 400 status
+// code and returning false if it is invalid.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// amount is an amount in the minor unit sent either as a number or as a
+// string.
+type amount int
+
+func (a *amount) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		return nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	*a = amount(v)
+	return nil
+}
+
+// ref is a reference to another object, sent either as an id, a code or the
+// object itself.
+type ref string
+
+func (r *ref) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '{' {
+		var o map[string]interface{}
+		if err := json.Unmarshal(data, &o); err != nil {
+			return err
+		}
+		for _, k := range []string{"customer_code", "plan_code", "recipient_code", "authorization_code", "email", "id"} {
+			switch v := o[k].(type) {
+			case string:
+				*r = ref(v)
+				return nil
+			case float64:
+				*r = ref(strconv.Itoa(int(v)))
+				return nil
+			}
+		}
+		return nil
+	}
+	*r = ref(strings.Trim(string(data), `"`))
+	if *r == "null" {
+		*r = ""
+	}
+	return nil
+}
+
+func (s *Server) nextID() int {
+	s.lastID++
+	return s.lastID
+}
+
+func now() *time.Time {
+	t := time.Now().UTC().Truncate(time.Second)
+	return &t
+}
+
+// inRange reports whether t is within the from and to query parameters,
+// which are ignored when empty or invalid.
+func inRange(t time.Time, from, to string) bool {
+	if f, err := time.Parse(time.RFC3339, from); err == nil && t.Before(f) {
+		return false
+	}
+	if u, err := time.Parse(time.RFC3339, to); err == nil && t.After(u) {
+		return false
+	}
+	return true
+}
+
+const codeAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// newCode returns prefix followed by 15 random lowercase alphanumerics,
+// like the codes generated by Paystack.
+func newCode(prefix string) string {
+	b := make([]byte, 15)
+	rand.Read(b)
+	for i := range b {
+		b[i] = codeAlphabet[int(b[i])%len(codeAlphabet)]
+	}
+	return prefix + string(b)
+}
@@ -0,0 +1,459 @@
+package paystacktest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kehindesalaam/go-paystack/paystack"
+)
+
+func TestServer_customer(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	created, _, err := client.Customer.Create(ctx, &paystack.CustomerRequest{
+		Email:     paystack.String("bojack@horsinaround.com"),
+		FirstName: paystack.String("Bojack"),
+	})
+	if err != nil {
+		t.Fatalf("Customer.Create returned error: %v", err)
+	}
+	fetched, _, err := client.Customer.Fetch(ctx, created.GetCustomerCode(), nil)
+	if err != nil {
+		t.Fatalf("Customer.Fetch returned error: %v", err)
+	}
+	if fetched.GetId() != created.GetId() || fetched.GetFirstName() != "Bojack" {
+		t.Errorf("Customer.Fetch returned %+v, want %+v", fetched, created)
+	}
+
+	_, _, err = client.Customer.Fetch(ctx, "CUS_unknown", nil)
+	var nf *paystack.NotFoundError
+	if !errors.As(err, &nf) {
+		t.Errorf("Customer.Fetch of unknown customer returned %v, want NotFoundError", err)
+	}
+}
+
+func TestServer_auth(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client(paystack.SecretKey("sk_test_wrong"))
+
+	_, _, err := client.Balance.Check(context.Background())
+	var ae *paystack.AuthError
+	if !errors.As(err, &ae) {
+		t.Errorf("Balance.Check returned %v, want AuthError", err)
+	}
+}
+
+func TestServer_transaction(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	auth, _, err := client.Transaction.Initialize(ctx, &paystack.TransactionRequest{
+		Email:  paystack.String("bojack@horsinaround.com"),
+		Amount: paystack.NewMoney(50000, paystack.NGN),
+	})
+	if err != nil {
+		t.Fatalf("Transaction.Initialize returned error: %v", err)
+	}
+	v, _, err := client.Transaction.Verify(ctx, auth.GetReference())
+	if err != nil {
+		t.Fatalf("Transaction.Verify returned error: %v", err)
+	}
+	if v.GetStatus() != "abandoned" {
+		t.Errorf("Transaction.Verify before payment returned status %q, want abandoned", v.GetStatus())
+	}
+
+	if err := srv.PayTransaction(auth.GetReference()); err != nil {
+		t.Fatalf("PayTransaction returned error: %v", err)
+	}
+	v, _, err = client.Transaction.Verify(ctx, auth.GetReference())
+	if err != nil {
+		t.Fatalf("Transaction.Verify returned error: %v", err)
+	}
+	if v.GetStatus() != "success" || v.GetAmount() != (paystack.Money{Amount: 50000, Currency: paystack.NGN}) {
+		t.Errorf("Transaction.Verify returned status %q and amount %v, want success and NGN 500.00", v.GetStatus(), v.GetAmount())
+	}
+
+	code := v.Authorization.GetAuthorizationCode()
+	tr, _, err := client.Transaction.ChargeAuthorization(ctx, &paystack.TransactionRequest{
+		Email:             paystack.String("bojack@horsinaround.com"),
+		Amount:            paystack.NewMoney(20000, paystack.NGN),
+		AuthorizationCode: paystack.String(code),
+	})
+	if err != nil {
+		t.Fatalf("Transaction.ChargeAuthorization returned error: %v", err)
+	}
+	if tr.GetStatus() != "success" || tr.Authorization.GetAuthorizationCode() != code {
+		t.Errorf("Transaction.ChargeAuthorization returned %+v", tr)
+	}
+
+	_, _, err = client.Transaction.Initialize(ctx, &paystack.TransactionRequest{
+		Email:     paystack.String("bojack@horsinaround.com"),
+		Amount:    paystack.NewMoney(50000, paystack.NGN),
+		Reference: auth.Reference,
+	})
+	var br *paystack.BadRequestError
+	if !errors.As(err, &br) || !errors.Is(err, paystack.ErrDuplicateReference) {
+		t.Errorf("Transaction.Initialize with a duplicate reference returned %v, want BadRequestError matching ErrDuplicateReference", err)
+	}
+}
+
+func TestServer_transfer(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+	srv.SetBalance(paystack.NGN, 100000)
+
+	rc, _, err := client.TransferRecipient.Create(ctx, &paystack.TransferRecipientRequest{
+		Type:          paystack.String("nuban"),
+		Name:          paystack.String("Zombie"),
+		AccountNumber: paystack.String("0100000010"),
+		BankCode:      paystack.String("044"),
+	})
+	if err != nil {
+		t.Fatalf("TransferRecipient.Create returned error: %v", err)
+	}
+	tr, _, err := client.Transfer.Initiate(ctx, &paystack.TransferRequest{
+		Recipient: rc.RecipientCode,
+		Amount:    paystack.NewMoney(60000, paystack.NGN),
+	})
+	if err != nil {
+		t.Fatalf("Transfer.Initiate returned error: %v", err)
+	}
+	if tr.GetStatus() != "otp" {
+		t.Errorf("Transfer.Initiate returned status %q, want otp", tr.GetStatus())
+	}
+
+	_, err = client.Transfer.Finalize(ctx, &paystack.FinalizeTransferRequest{
+		TransferCode: tr.TransferCode,
+		OTP:          paystack.String("000000"),
+	})
+	if !errors.Is(err, paystack.ErrInvalidOTP) {
+		t.Errorf("Transfer.Finalize with a wrong OTP returned %v, want ErrInvalidOTP", err)
+	}
+	_, err = client.Transfer.Finalize(ctx, &paystack.FinalizeTransferRequest{
+		TransferCode: tr.TransferCode,
+		OTP:          paystack.String(DefaultTransferOTP),
+	})
+	if err != nil {
+		t.Fatalf("Transfer.Finalize returned error: %v", err)
+	}
+	fetched, _, err := client.Transfer.Fetch(ctx, tr.GetTransferCode())
+	if err != nil {
+		t.Fatalf("Transfer.Fetch returned error: %v", err)
+	}
+	if fetched.GetStatus() != "success" {
+		t.Errorf("Transfer.Fetch returned status %q, want success", fetched.GetStatus())
+	}
+
+	balances, _, err := client.Balance.Check(ctx)
+	if err != nil {
+		t.Fatalf("Balance.Check returned error: %v", err)
+	}
+	if len(balances) != 1 || balances[0].GetBalance() != (paystack.Money{Amount: 40000, Currency: paystack.NGN}) {
+		t.Errorf("Balance.Check returned %+v, want a NGN balance of 40000", balances)
+	}
+
+	// The balance no longer covers a second transfer.
+	if _, _, err := client.Transfer.DisableOTPFinalize(ctx); err != nil {
+		t.Fatalf("Transfer.DisableOTPFinalize returned error: %v", err)
+	}
+	_, _, err = client.Transfer.Initiate(ctx, &paystack.TransferRequest{
+		Recipient: rc.RecipientCode,
+		Amount:    paystack.NewMoney(60000, paystack.NGN),
+	})
+	var br *paystack.BadRequestError
+	if !errors.As(err, &br) || !errors.Is(err, paystack.ErrInsufficientBalance) {
+		t.Errorf("Transfer.Initiate beyond the balance returned %v, want BadRequestError matching ErrInsufficientBalance", err)
+	}
+	transfers, _, err := client.Transfer.List(ctx, &paystack.TransferOptions{Recipient: rc.GetId(), Status: "success"})
+	if err != nil {
+		t.Fatalf("Transfer.List returned error: %v", err)
+	}
+	if len(transfers) != 1 || transfers[0].GetTransferCode() != tr.GetTransferCode() {
+		t.Errorf("Transfer.List returned %+v, want the successful transfer", transfers)
+	}
+	tomorrow := time.Now().Add(24 * time.Hour)
+	transfers, _, err = client.Transfer.List(ctx, &paystack.TransferOptions{From: tomorrow})
+	if err != nil || len(transfers) != 0 {
+		t.Errorf("Transfer.List from tomorrow returned %+v, %v, want none", transfers, err)
+	}
+}
+
+func TestServer_bulkCharge(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	auth, _, err := client.Transaction.Initialize(ctx, &paystack.TransactionRequest{
+		Email:  paystack.String("bojack@horsinaround.com"),
+		Amount: paystack.NewMoney(50000, paystack.NGN),
+	})
+	if err != nil {
+		t.Fatalf("Transaction.Initialize returned error: %v", err)
+	}
+	srv.PayTransaction(auth.GetReference())
+	v, _, err := client.Transaction.Verify(ctx, auth.GetReference())
+	if err != nil {
+		t.Fatalf("Transaction.Verify returned error: %v", err)
+	}
+
+	b, _, err := client.BulkCharge.Initiate(ctx, []*paystack.BulkBatchRequest{
+		{Authorization: v.Authorization.AuthorizationCode, Amount: paystack.NewMoney(1000, paystack.NGN)},
+		{Authorization: paystack.String("AUTH_unknown"), Amount: paystack.NewMoney(1000, paystack.NGN)},
+	})
+	if err != nil {
+		t.Fatalf("BulkCharge.Initiate returned error: %v", err)
+	}
+	fetched, _, err := client.BulkCharge.FetchBatch(ctx, b.GetBatchCode())
+	if err != nil {
+		t.Fatalf("BulkCharge.FetchBatch returned error: %v", err)
+	}
+	if fetched.GetStatus() != "complete" || fetched.GetTotalCharges() != 2 {
+		t.Errorf("BulkCharge.FetchBatch returned %+v", fetched)
+	}
+	var progress []paystack.BatchProgress
+	result, err := client.BulkCharge.WatchBatch(ctx, b.GetBatchCode(), &paystack.WatchOptions{
+		OnProgress: func(p paystack.BatchProgress) { progress = append(progress, p) },
+	})
+	if err != nil {
+		t.Fatalf("BulkCharge.WatchBatch returned error: %v", err)
+	}
+	want := paystack.BatchProgress{BatchCode: b.GetBatchCode(), Status: "complete", Total: 2, Charged: 1, Failed: 1}
+	if len(progress) != 1 || progress[0] != want {
+		t.Errorf("BulkCharge.WatchBatch reported %+v, want %+v", progress, want)
+	}
+	if len(result.Charged) != 1 || len(result.Failed) != 1 || result.Charged[0].Transaction.GetStatus() != "success" {
+		t.Errorf("BulkCharge.WatchBatch returned %+v", result)
+	}
+	retry, _, err := client.BulkCharge.RetryFailed(ctx, b.GetBatchCode(), nil)
+	if err != nil {
+		t.Fatalf("BulkCharge.RetryFailed returned error: %v", err)
+	}
+	charges, _, err := client.BulkCharge.FetchBatchCharges(ctx, retry.Batch.GetBatchCode(), nil)
+	if err != nil {
+		t.Fatalf("BulkCharge.FetchBatchCharges returned error: %v", err)
+	}
+	if len(charges) != 1 || charges[0].Metadata[paystack.MetadataRetryOfBatch] != b.GetBatchCode() ||
+		charges[0].GetReference() != retry.Retried[0].Request.GetReference() {
+		t.Errorf("BulkCharge.RetryFailed made charges %+v", charges)
+	}
+}
+
+func TestServer_Fail(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	srv.Fail("GET /balance", Failure{Status: 503, Times: 2})
+	client := srv.Client(paystack.Retry(paystack.RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}))
+	_, resp, err := client.Balance.Check(ctx)
+	if err != nil {
+		t.Fatalf("Balance.Check returned error: %v", err)
+	}
+	if resp.Attempts != 3 {
+		t.Errorf("Balance.Check took %d attempts, want 3", resp.Attempts)
+	}
+
+	srv.Fail("GET /balance", Failure{Status: 429, RetryAfter: time.Second, Times: 1})
+	_, _, err = srv.Client().Balance.Check(ctx)
+	var rle *paystack.RateLimitError
+	if !errors.As(err, &rle) {
+		t.Errorf("Balance.Check returned %v, want RateLimitError", err)
+	}
+
+	srv.Fail("GET /balance", Failure{Delay: time.Second})
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, _, err = srv.Client().Balance.Check(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Balance.Check returned %v, want context.DeadlineExceeded", err)
+	}
+	srv.ClearFailures()
+}
+
+func TestServer_FailLost(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	auth, _, err := client.Transaction.Initialize(ctx, &paystack.TransactionRequest{
+		Email:  paystack.String("bojack@horsinaround.com"),
+		Amount: paystack.NewMoney(50000, paystack.NGN),
+	})
+	if err != nil {
+		t.Fatalf("Transaction.Initialize returned error: %v", err)
+	}
+	if err := srv.PayTransaction(auth.GetReference()); err != nil {
+		t.Fatalf("PayTransaction returned error: %v", err)
+	}
+	v, _, err := client.Transaction.Verify(ctx, auth.GetReference())
+	if err != nil {
+		t.Fatalf("Transaction.Verify returned error: %v", err)
+	}
+
+	// The charge is made, but the client only sees a 502.
+	srv.Fail("POST /transaction/charge_authorization", Failure{Status: 502, Lost: true, Times: 1})
+	req := &paystack.TransactionRequest{
+		Email:             paystack.String("bojack@horsinaround.com"),
+		Amount:            paystack.NewMoney(20000, paystack.NGN),
+		AuthorizationCode: v.Authorization.AuthorizationCode,
+	}
+	tr, _, err := client.Transaction.ChargeAuthorizationOnce(ctx, req)
+	if err != nil {
+		t.Fatalf("Transaction.ChargeAuthorizationOnce returned error: %v", err)
+	}
+	if tr.GetReference() != req.GetReference() || tr.GetStatus() != "success" {
+		t.Errorf("Transaction.ChargeAuthorizationOnce returned %+v", tr)
+	}
+
+	ts, _, err := client.Transaction.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("Transaction.List returned error: %v", err)
+	}
+	if len(ts) != 2 {
+		t.Errorf("Transaction.List returned %d transactions, want the authorization charged once", len(ts))
+	}
+}
+
+func TestServer_FailLost_transfer(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+	srv.SetBalance(paystack.NGN, 100000)
+	client.Transfer.DisableOTPFinalize(ctx)
+
+	rc, _, err := client.TransferRecipient.Create(ctx, &paystack.TransferRecipientRequest{
+		Type:          paystack.String("nuban"),
+		Name:          paystack.String("Zombie"),
+		AccountNumber: paystack.String("0100000010"),
+		BankCode:      paystack.String("044"),
+	})
+	if err != nil {
+		t.Fatalf("TransferRecipient.Create returned error: %v", err)
+	}
+
+	// The transfer is made, but the client only sees a 502.
+	srv.Fail("POST /transfer", Failure{Status: 502, Lost: true, Times: 1})
+	req := &paystack.TransferRequest{Recipient: rc.RecipientCode, Amount: paystack.NewMoney(60000, paystack.NGN)}
+	tr, _, err := client.Transfer.InitiateOnce(ctx, req)
+	if err != nil {
+		t.Fatalf("Transfer.InitiateOnce returned error: %v", err)
+	}
+	if tr.GetReference() != req.GetReference() || tr.GetStatus() != "success" {
+		t.Errorf("Transfer.InitiateOnce returned %+v", tr)
+	}
+
+	balances, _, err := client.Balance.Check(ctx)
+	if err != nil {
+		t.Fatalf("Balance.Check returned error: %v", err)
+	}
+	if balances[0].GetBalance().Amount != 40000 {
+		t.Errorf("Balance.Check returned %v, want the transfer debited once", balances[0].GetBalance())
+	}
+}
+
+func TestServer_bulkTransfer(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+	srv.SetBalance(paystack.NGN, 100000)
+	client.Transfer.DisableOTPFinalize(ctx)
+
+	rc, _, err := client.TransferRecipient.Create(ctx, &paystack.TransferRecipientRequest{
+		Type:          paystack.String("nuban"),
+		Name:          paystack.String("Zombie"),
+		AccountNumber: paystack.String("0100000010"),
+		BankCode:      paystack.String("044"),
+	})
+	if err != nil {
+		t.Fatalf("TransferRecipient.Create returned error: %v", err)
+	}
+
+	// The balance covers 200 of the 250 transfers, and the response to the
+	// first chunk is lost.
+	req := &paystack.BulkTransferRequest{Source: paystack.String("balance")}
+	for i := 0; i < 250; i++ {
+		req.Transfers = append(req.Transfers, paystack.TransferRequest{
+			Recipient: rc.RecipientCode,
+			Amount:    paystack.NewMoney(500, paystack.NGN),
+			Reference: paystack.String(fmt.Sprintf("payroll-%03d", i)),
+		})
+	}
+	srv.Fail("POST /transfer/bulk", Failure{Status: 502, Lost: true, Times: 1})
+	results, err := client.Transfer.InitiateBulk(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("Transfer.InitiateBulk returned error: %v", err)
+	}
+	if len(results) != 250 || !errors.Is(results["payroll-000"].Err, paystack.ErrOutcomeUnknown) ||
+		results["payroll-100"].Transfer.GetStatus() != "received" {
+		t.Fatalf("Transfer.InitiateBulk returned %d results, payroll-000: %+v, payroll-100: %+v",
+			len(results), results["payroll-000"], results["payroll-100"])
+	}
+
+	if err := client.Transfer.WaitBulk(ctx, results, &paystack.PollOptions{Interval: time.Millisecond}); err != nil {
+		t.Fatalf("Transfer.WaitBulk returned error: %v", err)
+	}
+	failed := results.Failed()
+	if len(results.Pending()) != 0 || len(failed) != 50 || failed[0].Request.GetReference() != "payroll-200" {
+		t.Errorf("Transfer.WaitBulk left %d pending and %d failed transfers, want 0 and 50 from payroll-200",
+			len(results.Pending()), len(failed))
+	}
+	if !results["payroll-000"].Succeeded() || results["payroll-000"].Transfer.GetTransferCode() == "" {
+		t.Errorf("Transfer.WaitBulk returned %+v for the lost chunk, want a successful transfer", results["payroll-000"])
+	}
+}
+
+func TestServer_exportTransactions(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	var paid string
+	for _, amount := range []int64{150050, 20000} {
+		auth, _, err := client.Transaction.Initialize(ctx, &paystack.TransactionRequest{
+			Email:  paystack.String("bojack@horsinaround.com"),
+			Amount: paystack.NewMoney(amount, paystack.NGN),
+		})
+		if err != nil {
+			t.Fatalf("Transaction.Initialize returned error: %v", err)
+		}
+		if paid == "" {
+			paid = auth.GetReference()
+		}
+	}
+	if err := srv.PayTransaction(paid); err != nil {
+		t.Fatalf("PayTransaction returned error: %v", err)
+	}
+
+	opt := &paystack.TransactionOptions{Status: "success", From: time.Now().Add(-90 * 24 * time.Hour)}
+	var rows []*paystack.ExportRow
+	for row, err := range client.Transaction.ExportAndDownload(ctx, opt, nil) {
+		if err != nil {
+			t.Fatalf("Transaction.ExportAndDownload returned error: %v", err)
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("Transaction.ExportAndDownload returned %d rows, want 1", len(rows))
+	}
+	r := rows[0]
+	if r.Reference != paid || r.Amount != (paystack.Money{Amount: 150050, Currency: paystack.NGN}) ||
+		r.Fees.Amount != 2250 || r.Channel != "card" || r.CustomerEmail != "bojack@horsinaround.com" || r.PaidAt.IsZero() {
+		t.Errorf("Transaction.ExportAndDownload returned %+v", r)
+	}
+}
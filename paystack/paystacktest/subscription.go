@@ -0,0 +1,145 @@
+package paystacktest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/kehindesalaam/go-paystack/paystack"
+)
+
+// findSubscription returns the subscription with the given id or code.
+func (s *Server) findSubscription(key string) *paystack.Subscription {
+	for _, sub := range s.subscriptions {
+		if strconv.Itoa(sub.GetId()) == key || sub.GetSubscriptionCode() == key {
+			return sub
+		}
+	}
+	return nil
+}
+
+// findAuthorization returns the authorization of c with the given code.
+func findAuthorization(c *paystack.Customer, code string) *paystack.Authorization {
+	for i, a := range c.Authorizations {
+		if a.GetAuthorizationCode() == code {
+			return &c.Authorizations[i]
+		}
+	}
+	return nil
+}
+
+func (s *Server) createSubscription(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Customer      ref `json:"customer"`
+		Plan          ref `json:"plan"`
+		Authorization ref `json:"authorization"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	c := s.findCustomer(string(req.Customer))
+	if c == nil {
+		writeError(w, http.StatusBadRequest, "Customer not found")
+		return
+	}
+	p := s.findPlan(string(req.Plan))
+	if p == nil {
+		writeError(w, http.StatusBadRequest, "Plan not found")
+		return
+	}
+	var auth *paystack.Authorization
+	if req.Authorization != "" {
+		auth = findAuthorization(c, string(req.Authorization))
+	} else if len(c.Authorizations) > 0 {
+		auth = &c.Authorizations[len(c.Authorizations)-1]
+	}
+	if auth == nil {
+		writeError(w, http.StatusBadRequest, "Customer has no authorization to charge")
+		return
+	}
+
+	sub := &paystack.Subscription{
+		Customer:         *c,
+		Plan:             *p,
+		Authorization:    *auth,
+		Integration:      paystack.Int(100032),
+		Domain:           paystack.String("test"),
+		Status:           paystack.String("active"),
+		Quantity:         paystack.Int(1),
+		Amount:           p.Amount,
+		SubscriptionCode: paystack.String(newCode("SUB_")),
+		EmailToken:       paystack.String(newCode("")),
+		Id:               paystack.Int(s.nextID()),
+		CreatedAt:        now(),
+		UpdatedAt:        now(),
+	}
+	s.subscriptions = append(s.subscriptions, sub)
+	// Subscription creation returns the related objects as ids.
+	writeData(w, "Subscription successfully created", map[string]interface{}{
+		"customer":          c.Id,
+		"plan":              p.Id,
+		"integration":       sub.Integration,
+		"domain":            sub.Domain,
+		"status":            sub.Status,
+		"quantity":          sub.Quantity,
+		"amount":            sub.Amount,
+		"subscription_code": sub.SubscriptionCode,
+		"email_token":       sub.EmailToken,
+		"id":                sub.Id,
+		"created_at":        sub.CreatedAt,
+		"updated_at":        sub.UpdatedAt,
+	})
+}
+
+func (s *Server) listSubscriptions(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	var subs []*paystack.Subscription
+	for _, sub := range s.subscriptions {
+		if v := q.Get("customer"); v != "" && v != "0" && v != strconv.Itoa(sub.Customer.GetId()) {
+			continue
+		}
+		if v := q.Get("plan"); v != "" && v != strconv.Itoa(sub.Plan.GetId()) {
+			continue
+		}
+		subs = append(subs, sub)
+	}
+	writeList(w, r, "Subscriptions retrieved", subs)
+}
+
+func (s *Server) fetchSubscription(w http.ResponseWriter, r *http.Request) {
+	sub := s.findSubscription(r.PathValue("code"))
+	if sub == nil {
+		writeError(w, http.StatusNotFound, "Subscription not found")
+		return
+	}
+	writeData(w, "Subscription retrieved successfully", sub)
+}
+
+func (s *Server) disableSubscription(w http.ResponseWriter, r *http.Request) {
+	s.setSubscriptionStatus(w, r, "active", "cancelled", "Subscription disabled successfully")
+}
+
+func (s *Server) enableSubscription(w http.ResponseWriter, r *http.Request) {
+	s.setSubscriptionStatus(w, r, "cancelled", "active", "Subscription enabled successfully")
+}
+
+func (s *Server) setSubscriptionStatus(w http.ResponseWriter, r *http.Request, from, to, message string) {
+	var req struct {
+		Code  string `json:"code"`
+		Token string `json:"token"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	sub := s.findSubscription(req.Code)
+	if sub == nil || sub.GetEmailToken() != req.Token {
+		writeError(w, http.StatusNotFound, "Subscription with code not found or already inactive")
+		return
+	}
+	if sub.GetStatus() != from {
+		writeError(w, http.StatusBadRequest, "Subscription is already "+sub.GetStatus())
+		return
+	}
+	sub.Status = paystack.String(to)
+	sub.UpdatedAt = now()
+	writeJSON(w, http.StatusOK, envelope{Status: true, Message: message})
+}
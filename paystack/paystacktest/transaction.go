@@ -0,0 +1,266 @@
+package paystacktest
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kehindesalaam/go-paystack/paystack"
+)
+
+type transactionRequest struct {
+	Email             string             `json:"email"`
+	Amount            amount             `json:"amount"`
+	Currency          *paystack.Currency `json:"currency"`
+	Reference         string             `json:"reference"`
+	AuthorizationCode string             `json:"authorization_code"`
+	Plan              ref                `json:"plan"`
+	Metadata          interface{}        `json:"metadata"`
+}
+
+// findTransaction returns the transaction with the given reference.
+func (s *Server) findTransaction(reference string) *paystack.Transaction {
+	for _, t := range s.transactions {
+		if t.GetReference() == reference {
+			return t
+		}
+	}
+	return nil
+}
+
+// newTransaction validates req and records a new transaction for it.
+func (s *Server) newTransaction(req *transactionRequest, status string) (*paystack.Transaction, error) {
+	if req.Email == "" {
+		return nil, errors.New("Email is required")
+	}
+	if req.Amount <= 0 {
+		return nil, errors.New("Invalid amount")
+	}
+	if req.Reference == "" {
+		req.Reference = newCode("T")
+	} else if s.findTransaction(req.Reference) != nil {
+		return nil, errors.New("Duplicate Transaction Reference")
+	}
+	c := s.customerFor(req.Email)
+	t := &paystack.Transaction{
+		Id:        paystack.Int(s.nextID()),
+		Domain:    paystack.String("test"),
+		Status:    paystack.String(status),
+		Reference: paystack.String(req.Reference),
+		Currency:  paystack.NGN.Ptr(),
+		Customer:  *c,
+		CreatedAt: now(),
+	}
+	if req.Currency != nil {
+		t.Currency = req.Currency
+	}
+	t.Amount = paystack.NewMoney(int64(req.Amount), t.GetCurrency())
+	if p := s.findPlan(string(req.Plan)); p != nil {
+		t.Plan = *p
+	}
+	if m, ok := req.Metadata.(map[string]interface{}); ok {
+		t.Metadata = m
+	}
+	s.transactions = append(s.transactions, t)
+	return t, nil
+}
+
+// succeed marks t as paid with auth, and records auth on the customer if
+// it is new.
+func (s *Server) succeed(t *paystack.Transaction, auth paystack.Authorization) {
+	t.Status = paystack.String("success")
+	t.GatewayResponse = paystack.String("Successful")
+	t.Channel = auth.Channel
+	t.PaidAt = now()
+	t.TransactionDate = t.PaidAt
+	fees := t.GetAmount().Amount * 15 / 1000
+	if fees > 200000 {
+		fees = 200000
+	}
+	t.Fees = paystack.NewMoney(fees, t.GetCurrency())
+	t.Authorization = auth
+	if c := s.findCustomer(strconv.Itoa(t.Customer.GetId())); c != nil {
+		if findAuthorization(c, auth.GetAuthorizationCode()) == nil {
+			c.Authorizations = append(c.Authorizations, auth)
+		}
+		t.Customer = *c
+		t.Customer.Authorizations = nil
+	}
+}
+
+// PayTransaction completes the initialized transaction with the given
+// reference, as if the customer had paid it with a new reusable card.
+func (s *Server) PayTransaction(reference string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.findTransaction(reference)
+	if t == nil {
+		return errors.New("paystacktest: transaction not found")
+	}
+	if t.GetStatus() != "abandoned" {
+		return errors.New("paystacktest: transaction is already " + t.GetStatus())
+	}
+	s.succeed(t, paystack.Authorization{
+		AuthorizationCode: paystack.String(newCode("AUTH_")),
+		CardType:          paystack.String("visa"),
+		Last4:             paystack.String("4081"),
+		ExpMonth:          paystack.String("12"),
+		ExpYear:           paystack.String("2030"),
+		Bin:               paystack.String("408408"),
+		Bank:              paystack.String("TEST BANK"),
+		Channel:           paystack.String("card"),
+		Signature:         paystack.String(newCode("SIG_")),
+		Reusable:          paystack.Bool(true),
+		CountryCode:       paystack.String("NG"),
+	})
+	return nil
+}
+
+func (s *Server) initializeTransaction(w http.ResponseWriter, r *http.Request) {
+	var req transactionRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	t, err := s.newTransaction(&req, "abandoned")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	accessCode := newCode("")
+	writeData(w, "Authorization URL created", paystack.TransactionAuthorization{
+		AuthorizationUrl: paystack.String(s.URL + "pay/" + accessCode),
+		AccessCode:       paystack.String(accessCode),
+		Reference:        t.Reference,
+	})
+}
+
+func (s *Server) verifyTransaction(w http.ResponseWriter, r *http.Request) {
+	t := s.findTransaction(r.PathValue("reference"))
+	if t == nil {
+		writeError(w, http.StatusBadRequest, "Transaction reference not found")
+		return
+	}
+	// Verification returns the plan code rather than the plan object.
+	var v map[string]interface{}
+	b, _ := json.Marshal(t)
+	json.Unmarshal(b, &v)
+	v["plan"] = nil
+	if t.Plan.PlanCode != nil {
+		v["plan"] = t.Plan.PlanCode
+	}
+	writeData(w, "Verification successful", v)
+}
+
+func (s *Server) chargeAuthorization(w http.ResponseWriter, r *http.Request) {
+	var req transactionRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	c := s.findCustomer(req.Email)
+	if c == nil {
+		writeError(w, http.StatusBadRequest, "Customer not found")
+		return
+	}
+	auth := findAuthorization(c, req.AuthorizationCode)
+	if auth == nil {
+		writeError(w, http.StatusBadRequest, "Invalid authorization code")
+		return
+	}
+	if !auth.GetReusable() {
+		writeError(w, http.StatusBadRequest, "Authorization is not reusable")
+		return
+	}
+	t, err := s.newTransaction(&req, "abandoned")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.succeed(t, *auth)
+	writeData(w, "Charge attempted", t)
+}
+
+// filterTransactions returns the transactions matching the status,
+// customer, currency, from and to query parameters of r.
+func (s *Server) filterTransactions(r *http.Request) []*paystack.Transaction {
+	q := r.URL.Query()
+	var ts []*paystack.Transaction
+	for _, t := range s.transactions {
+		if v := q.Get("status"); v != "" && v != t.GetStatus() {
+			continue
+		}
+		if v := q.Get("customer"); v != "" && v != strconv.Itoa(t.Customer.GetId()) {
+			continue
+		}
+		if v := q.Get("currency"); v != "" && v != string(t.GetCurrency()) {
+			continue
+		}
+		if !inRange(t.GetCreatedAt(), q.Get("from"), q.Get("to")) {
+			continue
+		}
+		ts = append(ts, t)
+	}
+	return ts
+}
+
+func (s *Server) listTransactions(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, "Transactions retrieved", s.filterTransactions(r))
+}
+
+// exportTransactions writes the matching transactions to a CSV file, served
+// by downloadExport.
+func (s *Server) exportTransactions(w http.ResponseWriter, r *http.Request) {
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	cw.Write([]string{"Id", "Reference", "Amount", "Currency", "Status", "Channel", "Fees", "Customer Email", "Created At", "Paid At"})
+	for _, t := range s.filterTransactions(r) {
+		var paidAt string
+		if t.PaidAt != nil {
+			paidAt = t.PaidAt.Format(time.RFC3339)
+		}
+		cw.Write([]string{
+			strconv.Itoa(t.GetId()),
+			t.GetReference(),
+			t.GetAmount().Decimal(),
+			string(t.GetCurrency()),
+			t.GetStatus(),
+			t.GetChannel(),
+			t.GetFees().Decimal(),
+			t.Customer.GetEmail(),
+			t.GetCreatedAt().Format(time.RFC3339),
+			paidAt,
+		})
+	}
+	cw.Flush()
+	name := newCode("export_") + ".csv"
+	s.exports[name] = buf.Bytes()
+	writeData(w, "Export successful", paystack.ExportPath{Path: s.URL + "exports/" + name})
+}
+
+func (s *Server) downloadExport(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "" {
+		http.Error(w, "Only one auth mechanism allowed", http.StatusBadRequest)
+		return
+	}
+	b, ok := s.exports[r.PathValue("name")]
+	if !ok {
+		http.Error(w, "The specified key does not exist.", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv")
+	w.Write(b)
+}
+
+func (s *Server) fetchTransaction(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	for _, t := range s.transactions {
+		if strconv.Itoa(t.GetId()) == id {
+			writeData(w, "Transaction retrieved", t)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "Transaction not found")
+}
@@ -0,0 +1,318 @@
+package paystacktest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/kehindesalaam/go-paystack/paystack"
+)
+
+// SetBalance sets the balance of the integration in currency, in the minor
+// unit. Transfers are debited from it.
+func (s *Server) SetBalance(currency paystack.Currency, amount int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.balances[currency] = amount
+}
+
+func (s *Server) checkBalance(w http.ResponseWriter, r *http.Request) {
+	balances := []paystack.Balance{}
+	for currency, amount := range s.balances {
+		balances = append(balances, paystack.Balance{
+			Currency: currency.Ptr(),
+			Balance:  paystack.NewMoney(amount, currency),
+		})
+	}
+	writeData(w, "Balances retrieved", balances)
+}
+
+// findRecipient returns the recipient with the given id or code.
+func (s *Server) findRecipient(key string) *paystack.TransferRecipient {
+	for _, rc := range s.recipients {
+		if strconv.Itoa(rc.GetId()) == key || rc.GetRecipientCode() == key {
+			return rc
+		}
+	}
+	return nil
+}
+
+func (s *Server) createRecipient(w http.ResponseWriter, r *http.Request) {
+	var req paystack.TransferRecipientRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Type == nil || req.Name == nil || req.AccountNumber == nil || req.BankCode == nil {
+		writeError(w, http.StatusBadRequest, "Type, name, account number and bank code are required")
+		return
+	}
+	rc := &paystack.TransferRecipient{
+		Domain:      paystack.String("test"),
+		Type:        req.Type,
+		Currency:    paystack.NGN.Ptr(),
+		Name:        req.Name,
+		Description: req.Description,
+		Metadata:    req.Metadata,
+		Details: paystack.TransferRecipientDetails{
+			AccountNumber: req.AccountNumber,
+			AccountName:   req.Name,
+			BankCode:      req.BankCode,
+			BankName:      paystack.String("Test Bank"),
+		},
+		RecipientCode: paystack.String(newCode("RCP_")),
+		Active:        paystack.Bool(true),
+		Id:            paystack.Int(s.nextID()),
+		Integration:   paystack.Int(100032),
+		CreatedAt:     now(),
+		UpdatedAt:     now(),
+	}
+	if req.Currency != nil {
+		rc.Currency = req.Currency
+	}
+	s.recipients = append(s.recipients, rc)
+	writeData(w, "Recipient created", rc)
+}
+
+func (s *Server) listRecipients(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, "Recipients retrieved", s.recipients)
+}
+
+// findTransfer returns the transfer with the given id or code.
+func (s *Server) findTransfer(key string) *paystack.Transfer {
+	for _, t := range s.transfers {
+		if strconv.Itoa(t.GetId()) == key || t.GetTransferCode() == key {
+			return t
+		}
+	}
+	return nil
+}
+
+// findTransferByReference returns the transfer with the given reference.
+func (s *Server) findTransferByReference(reference string) *paystack.Transfer {
+	for _, t := range s.transfers {
+		if t.GetReference() == reference {
+			return t
+		}
+	}
+	return nil
+}
+
+// debit completes t by taking its amount from the balance, and reports
+// whether the balance was sufficient.
+func (s *Server) debit(t *paystack.Transfer) bool {
+	amount := t.GetAmount()
+	if s.balances[amount.Currency] < amount.Amount {
+		return false
+	}
+	s.balances[amount.Currency] -= amount.Amount
+	t.Status = paystack.String("success")
+	t.UpdatedAt = now()
+	return true
+}
+
+// transferRequest is a transfer as sent to /transfer and /transfer/bulk.
+type transferRequest struct {
+	Recipient ref                `json:"recipient"`
+	Amount    amount             `json:"amount"`
+	Currency  *paystack.Currency `json:"currency"`
+	Source    *string            `json:"source"`
+	Reason    *string            `json:"reason"`
+	Reference string             `json:"reference"`
+}
+
+// newTransfer validates req and records the transfer it makes, or returns
+// the message of the error. The transfer waits for an OTP if they are
+// required, otherwise it is debited.
+func (s *Server) newTransfer(req *transferRequest) (*paystack.Transfer, string) {
+	rc := s.findRecipient(string(req.Recipient))
+	if rc == nil {
+		return nil, "Recipient specified is invalid"
+	}
+	if req.Amount <= 0 {
+		return nil, "Invalid amount"
+	}
+	if req.Reference == "" {
+		req.Reference = newCode("")
+	} else if s.findTransferByReference(req.Reference) != nil {
+		return nil, "Duplicate Transfer Reference"
+	}
+	t := &paystack.Transfer{
+		Integration:  paystack.Int(100032),
+		Recipient:    *rc,
+		Domain:       paystack.String("test"),
+		Currency:     rc.Currency,
+		Source:       paystack.String("balance"),
+		Reason:       req.Reason,
+		Status:       paystack.String("otp"),
+		TransferCode: paystack.String(newCode("TRF_")),
+		Reference:    paystack.String(req.Reference),
+		Id:           paystack.Int(s.nextID()),
+		CreatedAt:    now(),
+		UpdatedAt:    now(),
+	}
+	if req.Currency != nil {
+		t.Currency = req.Currency
+	}
+	t.Amount = paystack.NewMoney(int64(req.Amount), t.GetCurrency())
+	s.transfers = append(s.transfers, t)
+	return t, ""
+}
+
+func (s *Server) initiateTransfer(w http.ResponseWriter, r *http.Request) {
+	var req transferRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	t, msg := s.newTransfer(&req)
+	if t == nil {
+		writeError(w, http.StatusBadRequest, msg)
+		return
+	}
+	if !s.transferOTP && !s.debit(t) {
+		s.transfers = s.transfers[:len(s.transfers)-1]
+		writeError(w, http.StatusBadRequest, "Your balance is not enough to fulfil this request")
+		return
+	}
+	message := "Transfer requires OTP to continue"
+	if !s.transferOTP {
+		message = "Transfer has been queued"
+	}
+	writeData(w, message, t)
+}
+
+// initiateBulkTransfer queues up to 100 transfers. They are all rejected if
+// one is invalid. Otherwise they are reported as received and debited in
+// turn, those beyond the balance failing.
+func (s *Server) initiateBulkTransfer(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Currency  *paystack.Currency `json:"currency"`
+		Source    *string            `json:"source"`
+		Transfers []transferRequest  `json:"transfers"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if s.transferOTP {
+		writeError(w, http.StatusBadRequest, "Bulk transfers cannot be initiated while OTP is enabled")
+		return
+	}
+	if len(req.Transfers) == 0 || len(req.Transfers) > 100 {
+		writeError(w, http.StatusBadRequest, "Transfers must hold between 1 and 100 transfers")
+		return
+	}
+	n := len(s.transfers)
+	var received []map[string]interface{}
+	for i := range req.Transfers {
+		tr := &req.Transfers[i]
+		if tr.Currency == nil {
+			tr.Currency = req.Currency
+		}
+		t, msg := s.newTransfer(tr)
+		if t == nil {
+			s.transfers = s.transfers[:n]
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("transfers[%d]: %s", i, msg))
+			return
+		}
+		received = append(received, map[string]interface{}{
+			"reference":     t.Reference,
+			"recipient":     t.Recipient.RecipientCode,
+			"amount":        t.Amount,
+			"currency":      t.Currency,
+			"transfer_code": t.TransferCode,
+			"status":        "received",
+		})
+	}
+	for _, t := range s.transfers[n:] {
+		if !s.debit(t) {
+			t.Status = paystack.String("failed")
+			t.UpdatedAt = now()
+		}
+	}
+	writeData(w, fmt.Sprintf("%d transfers queued.", len(received)), received)
+}
+
+func (s *Server) listTransfers(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	var ts []*paystack.Transfer
+	for _, t := range s.transfers {
+		if v := q.Get("recipient"); v != "" && v != strconv.Itoa(t.Recipient.GetId()) {
+			continue
+		}
+		if v := q.Get("status"); v != "" && v != t.GetStatus() {
+			continue
+		}
+		if !inRange(t.GetCreatedAt(), q.Get("from"), q.Get("to")) {
+			continue
+		}
+		ts = append(ts, t)
+	}
+	writeList(w, r, "Transfers retrieved", ts)
+}
+
+func (s *Server) fetchTransfer(w http.ResponseWriter, r *http.Request) {
+	t := s.findTransfer(r.PathValue("code"))
+	if t == nil {
+		writeError(w, http.StatusNotFound, "Transfer not found")
+		return
+	}
+	writeData(w, "Transfer retrieved", t)
+}
+
+func (s *Server) verifyTransfer(w http.ResponseWriter, r *http.Request) {
+	t := s.findTransferByReference(r.PathValue("reference"))
+	if t == nil {
+		writeError(w, http.StatusBadRequest, "Transfer not found")
+		return
+	}
+	writeData(w, "Transfer retrieved", t)
+}
+
+func (s *Server) finalizeTransfer(w http.ResponseWriter, r *http.Request) {
+	var req paystack.FinalizeTransferRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	t := s.findTransfer(req.GetTransferCode())
+	if t == nil {
+		writeError(w, http.StatusBadRequest, "Transfer code is invalid")
+		return
+	}
+	if t.GetStatus() != "otp" {
+		writeError(w, http.StatusBadRequest, "Transfer is not currently awaiting OTP")
+		return
+	}
+	if req.GetOTP() != s.TransferOTP {
+		writeError(w, http.StatusBadRequest, "Invalid OTP")
+		return
+	}
+	if !s.debit(t) {
+		writeError(w, http.StatusBadRequest, "Your balance is not enough to fulfil this request")
+		return
+	}
+	writeData(w, "Transfer has been queued", t)
+}
+
+func (s *Server) enableTransferOTP(w http.ResponseWriter, r *http.Request) {
+	s.transferOTP = true
+	writeJSON(w, http.StatusOK, envelope{Status: true, Message: "OTP requirement for transfers has been enabled"})
+}
+
+func (s *Server) disableTransferOTP(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, envelope{Status: true, Message: "OTP has been sent to mobile number ending with 4321"})
+}
+
+func (s *Server) disableTransferOTPFinalize(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		OTP string `json:"otp"`
+	}
+	// The client sends no body, so the OTP is only checked when given.
+	if r.ContentLength > 0 && !decode(w, r, &req) {
+		return
+	}
+	if req.OTP != "" && req.OTP != s.TransferOTP {
+		writeError(w, http.StatusBadRequest, "Invalid OTP")
+		return
+	}
+	s.transferOTP = false
+	writeJSON(w, http.StatusOK, envelope{Status: true, Message: "OTP requirement for transfers has been disabled"})
+}
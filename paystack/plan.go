@@ -3,6 +3,7 @@ package paystack
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 )
 
@@ -12,15 +13,15 @@ type PlanService service
 type Plan struct {
 	Name              *string            `json:"name, omitempty"`
 	Description       *string            `json:"description, omitempty"`
-	Amount            *int               `json:"amount, omitempty"`
+	Amount            *Money             `json:"amount, omitempty"`
 	Interval          *string            `json:"interval, omitempty"`
 	Domain            *string            `json:"domain, omitempty"`
 	PlanCode          *string            `json:"plan_code, omitempty"`
 	SendInvoices      *bool              `json:"send_invoices, omitempty"`
 	SendSms           *bool              `json:"send_sms, omitempty"`
 	HostedPage        *bool              `json:"hosted_page, omitempty"`
-	Currency          *string            `json:"currency, omitempty"`
-	InvoiceLimit      *string            `json:"invoice_limit, omitempty"`
+	Currency          *Currency          `json:"currency, omitempty"`
+	InvoiceLimit      *int               `json:"invoice_limit, omitempty"`
 	Id                *int               `json:"id, omitempty"`
 	CreatedAt         *time.Time         `json:"created_at, omitempty"`
 	UpdatedAt         *time.Time         `json:"updated_at, omitempty"`
@@ -30,6 +31,15 @@ type Plan struct {
 	HostedPageSummary *string            `json:"hosted_page_summary, omitempty"`
 }
 
+// UnmarshalJSON also accepts the plan id or code, which some endpoints
+// return in place of the plan object.
+func (p *Plan) UnmarshalJSON(data []byte) error {
+	type plan Plan
+	return unmarshalRef(data, (*plan)(p),
+		func(id int) { p.Id = &id },
+		func(code string) { p.PlanCode = &code })
+}
+
 type PlanSubscription struct {
 	Customer         *int        `json:"customer"`
 	Plan             *int        `json:"plan, omitempty"`
@@ -38,7 +48,7 @@ type PlanSubscription struct {
 	Start            *int64      `json:"start, omitempty"`
 	Status           *string     `json:"status, omitempty"`
 	Quantity         *int        `json:"quantity, omitempty"`
-	Amount           *int        `json:"amount, omitempty"`
+	Amount           *Money      `json:"amount, omitempty"`
 	SubscriptionCode *string     `json:"subscription_code, omitempty"`
 	EmailToken       *string     `json:"email_token, omitempty"`
 	Authorization    *int        `json:"authorization, omitempty"`
@@ -52,14 +62,14 @@ type PlanSubscription struct {
 }
 
 type PlanRequest struct {
-	Name         *string `json:"name, omitempty"`
-	Description  *string `json:"description, omitempty"`
-	Amount       *int    `json:"amount, omitempty"`
-	Interval     *string `json:"interval, omitempty"`
-	SendInvoices *bool   `json:"send_invoices, omitempty"`
-	SendSms      *bool   `json:"send_sms, omitempty"`
-	Currency     *string `json:"currency, omitempty"`
-	InvoiceLimit *string `json:"invoice_limit, omitempty"`
+	Name         *string   `json:"name, omitempty"`
+	Description  *string   `json:"description, omitempty"`
+	Amount       *Money    `json:"amount, omitempty"`
+	Interval     *string   `json:"interval, omitempty"`
+	SendInvoices *bool     `json:"send_invoices, omitempty"`
+	SendSms      *bool     `json:"send_sms, omitempty"`
+	Currency     *Currency `json:"currency, omitempty"`
+	InvoiceLimit *int      `json:"invoice_limit, omitempty"`
 }
 
 // Create returns a new plan
@@ -72,14 +82,12 @@ func (s *PlanService) Create(ctx context.Context, p *PlanRequest) (*Plan, *Respo
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Plan])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	var pr Plan
-	mapDecoder(r.Data, pr)
-	return &pr, resp, nil
+	return &r.Data, resp, nil
 }
 
 // List returns an array all created plans
@@ -98,20 +106,25 @@ func (s *PlanService) List(ctx context.Context, opt *PlanOptions) ([]Plan, *Resp
 		return nil, nil, err
 	}
 
-	lr := new(StandardListResponse)
+	lr := new(Envelope[[]Plan])
 	resp, err := s.client.Do(ctx, req, lr)
-
 	if err != nil {
 		return nil, resp, err
 	}
+	return lr.Data, resp, nil
+}
 
-	var pa []Plan
-	p := new(Plan)
-	for _, x := range lr.Data {
-		mapDecoder(x, p)
-		pa = append(pa, *p)
+// ListAll iterates over all plans, calling List for each page
+func (s *PlanService) ListAll(ctx context.Context, opt *PlanOptions, iopt *IterOptions) iter.Seq2[Plan, error] {
+	var o PlanOptions
+	if opt != nil {
+		o = *opt
 	}
-	return pa, resp, nil
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]Plan, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
 }
 
 // Fetch returns a plan with the passed id parameter
@@ -124,14 +137,12 @@ func (s *PlanService) Fetch(ctx context.Context, id string) (*Plan, *Response, e
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Plan])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	var pr Plan
-	mapDecoder(r.Data, pr)
-	return &pr, resp, nil
+	return &r.Data, resp, nil
 }
 
 // Update updates a plan model with the supplied id
@@ -144,12 +155,10 @@ func (s *PlanService) Update(ctx context.Context, sa *Plan, id string) (*PlanSub
 	if err != nil {
 		return nil, nil, err
 	}
-	pr := new(StandardResponse)
+	pr := new(Envelope[PlanSubscription])
 	resp, err := s.client.Do(ctx, req, pr)
 	if err != nil {
 		return nil, resp, err
 	}
-	var p PlanSubscription
-	mapDecoder(pr.Data, p)
-	return &p, resp, nil
+	return &pr.Data, resp, nil
 }
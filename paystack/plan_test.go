@@ -0,0 +1,51 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPlanService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/plan", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Plan created",
+		  "data": {
+			"name": "Monthly retainer",
+			"interval": "monthly",
+			"amount": 500000,
+			"integration": 428626,
+			"domain": "test",
+			"currency": "NGN",
+			"plan_code": "PLN_u4cqud8vabi89ys",
+			"invoice_limit": 0,
+			"send_invoices": true,
+			"send_sms": true,
+			"hosted_page": false,
+			"id": 28,
+			"createdAt": "2016-03-29T22:42:50.811Z",
+			"updatedAt": "2016-03-29T22:42:50.811Z"
+		  }
+		}`)
+	})
+
+	plan, _, err := client.Plan.Create(context.Background(), &PlanRequest{Name: String("Monthly retainer"), Interval: String("monthly"), Amount: NewMoney(500000, NGN)})
+	if err != nil {
+		t.Errorf("Plan.Create returned error: %v", err)
+	}
+
+	want := &Plan{Name: String("Monthly retainer"), Interval: String("monthly"), Amount: NewMoney(500000, NGN), Integration: Int(428626),
+		Domain: String("test"), Currency: NGN.Ptr(), PlanCode: String("PLN_u4cqud8vabi89ys"), InvoiceLimit: Int(0),
+		SendInvoices: Bool(true), SendSms: Bool(true), HostedPage: Bool(false), Id: Int(28)}
+	if !cmp.Equal(plan, want) {
+		t.Errorf("Plan.Create returned %+v, want %+v", plan, want)
+	}
+}
@@ -0,0 +1,80 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultPollInterval    = 2 * time.Second
+	defaultPollMaxInterval = 30 * time.Second
+)
+
+// PollOptions sets how often the helpers waiting on Paystack, such as
+// ChargeFlow.Wait, check for progress. The delay between two checks starts
+// at Interval and doubles after each check, up to MaxInterval.
+type PollOptions struct {
+	// Interval defaults to 2s when zero.
+	Interval time.Duration
+
+	// MaxInterval defaults to 30s when zero.
+	MaxInterval time.Duration
+}
+
+// delay returns the delay before check n+1, counting from 0.
+func (o *PollOptions) delay(n int) time.Duration {
+	d, max := defaultPollInterval, defaultPollMaxInterval
+	if o != nil && o.Interval > 0 {
+		d = o.Interval
+	}
+	if o != nil && o.MaxInterval > 0 {
+		max = o.MaxInterval
+	}
+	for i := 0; i < n && d < max; i++ {
+		d *= 2
+	}
+	return min(d, max)
+}
+
+// poll calls check until it reports done or fails, waiting between calls as
+// set by o. Errors that the retry policy would retry, such as a 5xx or a
+// timeout, are retried at the next check. If ctx ends first, poll returns
+// ctx.Err(), wrapping the last of those errors if any.
+func poll(ctx context.Context, o *PollOptions, check func() (bool, error)) error {
+	var last error
+	for n := 0; ; n++ {
+		done, err := check()
+		switch {
+		case err != nil && ctx.Err() == nil && isTransient(err):
+			last = err
+		case done || err != nil:
+			return err
+		}
+		t := time.NewTimer(o.delay(n))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			if last != nil {
+				return fmt.Errorf("%w (last error: %w)", ctx.Err(), last)
+			}
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// isTransient reports whether err, returned by a service method, is worth
+// retrying, as decided by shouldRetry.
+func isTransient(err error) bool {
+	var rl *RateLimitError
+	if errors.As(err, &rl) {
+		return true
+	}
+	var er *ErrorResponse
+	if errors.As(err, &er) {
+		return er.Response != nil && shouldRetry(&Response{Response: er.Response}, err)
+	}
+	return isRetryableError(err)
+}
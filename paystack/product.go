@@ -0,0 +1,143 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// ProductService handles the communication with the Products related parts of the Paystack API
+type ProductService service
+
+type Product struct {
+	Id               *int        `json:"id,omitempty"`
+	Integration      *int        `json:"integration,omitempty"`
+	Domain           *string     `json:"domain,omitempty"`
+	Name             *string     `json:"name,omitempty"`
+	Description      *string     `json:"description,omitempty"`
+	ProductCode      *string     `json:"product_code,omitempty"`
+	Slug             *string     `json:"slug,omitempty"`
+	Price            *Money      `json:"price,omitempty"`
+	Currency         *Currency   `json:"currency,omitempty"`
+	Quantity         *int        `json:"quantity,omitempty"`
+	QuantitySold     *int        `json:"quantity_sold,omitempty"`
+	Unlimited        *bool       `json:"unlimited,omitempty"`
+	InStock          *bool       `json:"in_stock,omitempty"`
+	Active           *bool       `json:"active,omitempty"`
+	Type             *string     `json:"type,omitempty"`
+	Metadata         MetadataMap `json:"metadata,omitempty"`
+	MinimumOrderable *int        `json:"minimum_orderable,omitempty"`
+	MaximumOrderable *int        `json:"maximum_orderable,omitempty"`
+	CreatedAt        *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time  `json:"updated_at,omitempty"`
+}
+
+// ProductRequest creates or updates a product. Price is in the minor unit.
+// Quantity is the stock available, and is ignored when Unlimited is set.
+type ProductRequest struct {
+	Name        *string   `json:"name,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Price       *Money    `json:"price,omitempty"`
+	Currency    *Currency `json:"currency,omitempty"`
+	Unlimited   *bool     `json:"unlimited,omitempty"`
+	Quantity    *int      `json:"quantity,omitempty"`
+}
+
+// ProductOptions specifies the optional parameters to ProductService.List
+type ProductOptions struct {
+	ListOptions
+
+	// From and To limit products to those created in the range.
+	From time.Time `url:"from,omitempty"`
+	To   time.Time `url:"to,omitempty"`
+}
+
+// Create adds a product to the catalogue
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#create-product
+func (s *ProductService) Create(ctx context.Context, pr *ProductRequest) (*Product, *Response, error) {
+	u := fmt.Sprintf("product")
+	req, err := s.client.NewRequest("POST", u, pr)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Product])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// List returns the products in the catalogue
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#list-products
+func (s *ProductService) List(ctx context.Context, opt *ProductOptions) ([]Product, *Response, error) {
+	u := fmt.Sprintf("product")
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	lr := new(Envelope[[]Product])
+	resp, err := s.client.Do(ctx, req, lr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lr.Data, resp, nil
+}
+
+// ListAll iterates over all products, calling List for each page
+func (s *ProductService) ListAll(ctx context.Context, opt *ProductOptions, iopt *IterOptions) iter.Seq2[Product, error] {
+	var o ProductOptions
+	if opt != nil {
+		o = *opt
+	}
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]Product, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
+}
+
+// Fetch returns the details of a product
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#fetch-product
+func (s *ProductService) Fetch(ctx context.Context, id string) (*Product, *Response, error) {
+	u := fmt.Sprintf("product/" + id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Product])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// Update updates a product
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#update-product
+func (s *ProductService) Update(ctx context.Context, pr *ProductRequest, id string) (*Product, *Response, error) {
+	u := fmt.Sprintf("product/" + id)
+	req, err := s.client.NewRequest("PUT", u, pr)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Product])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
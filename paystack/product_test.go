@@ -0,0 +1,102 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProductService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/product", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		want := map[string]interface{}{"name": "Puff Puff", "description": "Crispy flour ball", "price": float64(5000), "currency": "NGN", "unlimited": false, "quantity": float64(100)}
+		if !cmp.Equal(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Product successfully created",
+		  "data": {
+			"name": "Puff Puff",
+			"description": "Crispy flour ball",
+			"currency": "NGN",
+			"price": 5000,
+			"quantity": 100,
+			"quantity_sold": null,
+			"unlimited": false,
+			"in_stock": true,
+			"integration": 463433,
+			"domain": "test",
+			"product_code": "PROD_oy4flx2uodbpdt6",
+			"slug": "puff-puff-prqdu6",
+			"active": true,
+			"id": 526
+		  }
+		}`)
+	})
+
+	product, _, err := client.Product.Create(context.Background(), &ProductRequest{
+		Name:        String("Puff Puff"),
+		Description: String("Crispy flour ball"),
+		Price:       NewMoney(5000, NGN),
+		Currency:    NGN.Ptr(),
+		Unlimited:   Bool(false),
+		Quantity:    Int(100),
+	})
+	if err != nil {
+		t.Errorf("Product.Create returned error: %v", err)
+	}
+
+	want := &Product{
+		Name:        String("Puff Puff"),
+		Description: String("Crispy flour ball"),
+		Currency:    NGN.Ptr(),
+		Price:       NewMoney(5000, NGN),
+		Quantity:    Int(100),
+		Unlimited:   Bool(false),
+		InStock:     Bool(true),
+		Integration: Int(463433),
+		Domain:      String("test"),
+		ProductCode: String("PROD_oy4flx2uodbpdt6"),
+		Slug:        String("puff-puff-prqdu6"),
+		Active:      Bool(true),
+		Id:          Int(526),
+	}
+	if !cmp.Equal(product, want) {
+		t.Errorf("Product.Create returned %+v, want %+v", product, want)
+	}
+}
+
+func TestProductService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/product/526", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if want := map[string]interface{}{"unlimited": true}; !cmp.Equal(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		fmt.Fprint(w, `{"status": true, "message": "Product successfully updated", "data": {"id": 526, "unlimited": true}}`)
+	})
+
+	product, _, err := client.Product.Update(context.Background(), &ProductRequest{Unlimited: Bool(true)}, "526")
+	if err != nil {
+		t.Errorf("Product.Update returned error: %v", err)
+	}
+
+	want := &Product{Id: Int(526), Unlimited: Bool(true)}
+	if !cmp.Equal(product, want) {
+		t.Errorf("Product.Update returned %+v, want %+v", product, want)
+	}
+}
@@ -0,0 +1,204 @@
+package paystack
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+)
+
+// Rate represents the rate limit state reported by the Paystack API.
+type Rate struct {
+	// The number of requests allowed in the current window.
+	Limit int
+
+	// The number of requests remaining in the current window.
+	Remaining int
+
+	// The time at which the current rate limit window resets.
+	Reset time.Time
+}
+
+func (r Rate) String() string {
+	return fmt.Sprintf("%d/%d, resets at %v", r.Remaining, r.Limit, r.Reset)
+}
+
+// RateLimitError occurs when the Paystack API rate limit has been exhausted,
+// either reported by the API with a 429 status code or detected locally by
+// the client before sending the request.
+type RateLimitError struct {
+	Rate     Rate           // Rate specifies last known rate limit for the client
+	Response *http.Response // HTTP response that caused this error
+	Message  string         `json:"message"` // error message
+
+	// ErrorResponse holds the decoded body of the response, with its code,
+	// request id and field errors.
+	ErrorResponse *ErrorResponse
+}
+
+func (r *RateLimitError) Error() string {
+	return fmt.Sprintf("%v %v: %d %v %v",
+		r.Response.Request.Method, r.Response.Request.URL,
+		r.Response.StatusCode, r.Message, formatRateReset(time.Until(r.Rate.Reset)))
+}
+
+// Unwrap returns the *ErrorResponse of r, for use with errors.Is and
+// errors.As.
+func (r *RateLimitError) Unwrap() error {
+	if r.ErrorResponse == nil {
+		return nil
+	}
+	return r.ErrorResponse
+}
+
+// formatRateReset formats d to look like "[rate reset in 2s]" or
+// "[rate limit was reset 2s ago]".
+func formatRateReset(d time.Duration) string {
+	if d < 0 {
+		return fmt.Sprintf("[rate limit was reset %v ago]", (-d).Round(time.Second))
+	}
+	return fmt.Sprintf("[rate reset in %v]", d.Round(time.Second))
+}
+
+// parseRate parses the rate limit headers of r. When the response is a 429
+// without a reset header, the Retry-After header is used to compute the reset.
+func parseRate(r *http.Response) Rate {
+	var rate Rate
+	if limit := r.Header.Get(headerRateLimit); limit != "" {
+		rate.Limit, _ = strconv.Atoi(limit)
+	}
+	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
+		rate.Remaining, _ = strconv.Atoi(remaining)
+	}
+	if reset := r.Header.Get(headerRateReset); reset != "" {
+		if v, _ := strconv.ParseInt(reset, 10, 64); v != 0 {
+			rate.Reset = time.Unix(v, 0)
+		}
+	}
+	if rate.Reset.IsZero() && r.StatusCode == http.StatusTooManyRequests {
+		if d := retryAfter(&Response{Response: r}); d > 0 {
+			rate.Reset = time.Now().Add(d)
+		}
+	}
+	return rate
+}
+
+// Rate returns the rate limit state from the most recent API response
+// that reported one.
+func (c *Client) Rate() Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	return c.rate
+}
+
+// updateRate records the rate limit state reported by resp.
+func (c *Client) updateRate(resp *Response) {
+	exhausted := resp.StatusCode == http.StatusTooManyRequests
+	if resp.Rate.Limit == 0 && !exhausted {
+		return
+	}
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	c.rate = resp.Rate
+	if exhausted || resp.Rate.Remaining == 0 {
+		c.rateLimitReset = resp.Rate.Reset
+	} else {
+		c.rateLimitReset = time.Time{}
+	}
+}
+
+// checkRateLimitBeforeDo does not make any network calls, but uses existing
+// knowledge from the current client state in order to quickly check if the
+// rate limit is exhausted and avoid making network calls when it is.
+func (c *Client) checkRateLimitBeforeDo(req *http.Request) *RateLimitError {
+	c.rateMu.Lock()
+	rate, reset := c.rate, c.rateLimitReset
+	c.rateMu.Unlock()
+	if reset.IsZero() || !time.Now().Before(reset) {
+		return nil
+	}
+
+	// Create a fake response, with the Retry-After header set so that a
+	// retry policy waits for the reset before trying again.
+	resp := &http.Response{
+		Status:     http.StatusText(http.StatusTooManyRequests),
+		StatusCode: http.StatusTooManyRequests,
+		Request:    req,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+	}
+	resp.Header.Set("Retry-After", strconv.Itoa(int(time.Until(reset)/time.Second)+1))
+	rate.Reset = reset
+	message := "API rate limit still exhausted, not making remote request"
+	return &RateLimitError{
+		Rate:          rate,
+		Response:      resp,
+		Message:       message,
+		ErrorResponse: &ErrorResponse{Response: resp, Message: message},
+	}
+}
+
+// RateLimit is a referential function that makes the client throttle its
+// own requests to at most rps requests per second, allowing bursts of up to
+// burst requests, when initializing the client
+func RateLimit(rps float64, burst int) func(*Client) {
+	return func(c *Client) {
+		if burst < 1 {
+			burst = 1
+		}
+		c.limiter = &tokenBucket{rate: rps, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+	}
+}
+
+// tokenBucket is a client side token bucket rate limiter.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64 // maximum number of tokens
+	tokens float64
+	last   time.Time
+}
+
+// wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	if b.rate <= 0 {
+		return nil
+	}
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+	// Reserve a token now, possibly going into debt, so that waiters are
+	// served in order.
+	b.tokens--
+	deficit := -b.tokens
+	b.mu.Unlock()
+	if deficit <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(time.Duration(deficit / b.rate * float64(time.Second)))
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,107 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestDo_rateLimitError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	reset := time.Now().Add(time.Minute).Truncate(time.Second)
+	calls := 0
+	mux.HandleFunc("/balance", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set(headerRateLimit, "100")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, strconv.FormatInt(reset.Unix(), 10))
+		w.Header().Set(headerRequestID, "req-429")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"status": false, "message": "Too many requests", "code": "rate_limited"}`)
+	})
+
+	_, _, err := client.Balance.Check(context.Background())
+	rerr, ok := err.(*RateLimitError)
+	if !ok {
+		t.Fatalf("Balance.Check returned error %#v, want *RateLimitError", err)
+	}
+	var er *ErrorResponse
+	if !errors.As(err, &er) || er.Message != "Too many requests" || er.RequestID != "req-429" || er.Code != "rate_limited" {
+		t.Errorf("Balance.Check returned %+v, want it to unwrap to the *ErrorResponse", err)
+	}
+	if rerr.Message != "Too many requests" {
+		t.Errorf("RateLimitError.Message = %q, want %q", rerr.Message, "Too many requests")
+	}
+	want := Rate{Limit: 100, Remaining: 0, Reset: reset}
+	if !rerr.Rate.Reset.Equal(want.Reset) || rerr.Rate.Limit != want.Limit {
+		t.Errorf("RateLimitError.Rate = %v, want %v", rerr.Rate, want)
+	}
+	if got := client.Rate(); got.Limit != 100 || !got.Reset.Equal(reset) {
+		t.Errorf("Client.Rate() = %v, want %v", got, want)
+	}
+
+	// The limit is known to be exhausted, so the next call must not reach
+	// the server.
+	_, resp, err := client.Balance.Check(context.Background())
+	if _, ok := err.(*RateLimitError); !ok {
+		t.Fatalf("second Balance.Check returned error %#v, want *RateLimitError", err)
+	}
+	if calls != 1 {
+		t.Errorf("server received %d calls, want 1", calls)
+	}
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("second Balance.Check returned response %v, want a 429 response", resp)
+	}
+}
+
+func TestDo_rateLimitRecovers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/balance", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "100")
+		w.Header().Set(headerRateRemaining, "99")
+		w.Header().Set(headerRateReset, strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+		fmt.Fprint(w, `{"status": true, "message": "Balances retrieved", "data": []}`)
+	})
+
+	_, resp, err := client.Balance.Check(context.Background())
+	if err != nil {
+		t.Fatalf("Balance.Check returned error: %v", err)
+	}
+	if resp.Rate.Remaining != 99 {
+		t.Errorf("Response.Rate.Remaining = %d, want 99", resp.Rate.Remaining)
+	}
+	if _, _, err := client.Balance.Check(context.Background()); err != nil {
+		t.Errorf("second Balance.Check returned error: %v", err)
+	}
+}
+
+func TestTokenBucket_wait(t *testing.T) {
+	c := NewClient(nil, RateLimit(1000, 2))
+	ctx := context.Background()
+	start := time.Now()
+	for i := 0; i < 12; i++ {
+		if err := c.limiter.wait(ctx); err != nil {
+			t.Fatalf("wait returned error: %v", err)
+		}
+	}
+	// 2 requests are served from the burst, the other 10 at 1ms each.
+	if d := time.Since(start); d < 9*time.Millisecond {
+		t.Errorf("12 waits took %v, want at least 9ms", d)
+	}
+
+	c = NewClient(nil, RateLimit(0.001, 1))
+	c.limiter.wait(ctx)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := c.limiter.wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("wait returned %v, want %v", err, context.DeadlineExceeded)
+	}
+}
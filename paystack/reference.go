@@ -0,0 +1,130 @@
+package paystack
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultOnceRetries is the number of times the Once methods resubmit a
+// request found not to have been processed, when the client has no retry
+// policy.
+const defaultOnceRetries = 2
+
+// ErrOutcomeUnknown is returned by the Once methods, such as
+// Transfer.InitiateOnce, when an attempt failed in a way that leaves its
+// outcome unknown and verifying it by reference failed too.
+var ErrOutcomeUnknown = errors.New("paystack: outcome unknown")
+
+// errNilRequest is returned by the methods that need a request to set its
+// reference when given none.
+var errNilRequest = errors.New("paystack: request is nil")
+
+// NewReference returns a new collision resistant reference for a
+// transaction or transfer. It is made of the current time and 80 random
+// bits, and only uses characters accepted by Paystack in references.
+func NewReference() string {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		panic("paystack: reading random bytes: " + err.Error())
+	}
+	return strconv.FormatInt(time.Now().UnixMilli(), 36) + "-" + hex.EncodeToString(b)
+}
+
+// AutoReference is a referential function that makes the client generate a
+// reference with gen for transactions and transfers submitted without one.
+// The reference is set on the request, so that the caller can verify the
+// outcome of a failed submission. gen defaults to NewReference when nil.
+func AutoReference(gen func() string) func(*Client) {
+	if gen == nil {
+		gen = NewReference
+	}
+	return func(c *Client) {
+		c.newReference = gen
+	}
+}
+
+// setReference generates *ref if it is unset and the client generates
+// references.
+func (c *Client) setReference(ref **string) {
+	if c.newReference != nil && (*ref == nil || **ref == "") {
+		*ref = String(c.newReference())
+	}
+}
+
+// ensureReference generates *ref if it is unset, with the generator of the
+// client or NewReference.
+func (c *Client) ensureReference(ref **string) {
+	if *ref != nil && **ref != "" {
+		return
+	}
+	if c.newReference != nil {
+		*ref = String(c.newReference())
+	} else {
+		*ref = String(NewReference())
+	}
+}
+
+// submitOnce calls submit until it succeeds, at most once more than the
+// retries of the client policy. After an attempt whose outcome is unknown,
+// it verifies reference and returns the verified object if the attempt went
+// through, so that the request is processed at most once.
+func submitOnce[T any](ctx context.Context, c *Client, reference string,
+	submit func(context.Context) (*T, *Response, error),
+	verify func(context.Context, string) (*T, *Response, error)) (*T, *Response, error) {
+	p := c.retry
+	if p == nil {
+		p = &RetryPolicy{MaxRetries: defaultOnceRetries}
+	}
+	for attempt := 0; ; attempt++ {
+		v, resp, err := submit(ctx)
+		switch {
+		case err == nil:
+			return v, resp, nil
+		case attempt > 0 && errors.Is(err, ErrDuplicateReference):
+			// An earlier attempt went through after all.
+			return verify(ctx, reference)
+		case !isAmbiguous(resp, err):
+			return nil, resp, err
+		}
+
+		v, vresp, verr := verify(ctx, reference)
+		switch {
+		case verr == nil:
+			return v, vresp, nil
+		case !isNotFound(verr):
+			return nil, resp, fmt.Errorf("%w: reference %s: %w", ErrOutcomeUnknown, reference, err)
+		case attempt >= p.MaxRetries || !sleepCtx(ctx, p.backoff(attempt)):
+			// The request was not processed, so it is safe to submit again.
+			return nil, resp, err
+		}
+	}
+}
+
+// isAmbiguous reports whether a request that failed with resp and err may
+// have been processed nonetheless, as when the connection dropped or
+// Paystack failed while processing it.
+func isAmbiguous(resp *Response, err error) bool {
+	if resp != nil && resp.Response != nil {
+		return resp.StatusCode >= 500
+	}
+	var ue *url.Error
+	return errors.As(err, &ue) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// isNotFound reports whether err reports an unknown reference, which
+// Paystack does with either a 404 or a 400 status.
+func isNotFound(err error) bool {
+	var er *ErrorResponse
+	if !errors.As(err, &er) {
+		return false
+	}
+	return er.Response != nil && er.Response.StatusCode == 404 || strings.HasSuffix(er.Code, "_not_found") ||
+		strings.Contains(strings.ToLower(er.Message), "not found")
+}
@@ -0,0 +1,218 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestNewReference(t *testing.T) {
+	a, b := NewReference(), NewReference()
+	if a == b {
+		t.Errorf("NewReference returned %q twice", a)
+	}
+	if !regexp.MustCompile(`^[0-9a-z]+-[0-9a-f]{20}$`).MatchString(a) {
+		t.Errorf("NewReference returned %q", a)
+	}
+}
+
+func TestAutoReference(t *testing.T) {
+	setup()
+	defer teardown()
+	AutoReference(func() string { return "ref-1" })(client)
+
+	mux.HandleFunc("/transaction/initialize", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["reference"] != "ref-1" {
+			t.Errorf("Request reference = %v, want ref-1", body["reference"])
+		}
+		fmt.Fprint(w, `{"status": true, "message": "Authorization URL created", "data": {"reference": "ref-1"}}`)
+	})
+
+	tr := &TransactionRequest{Email: String("bojack@horsinaround.com"), Amount: NewMoney(10000, NGN)}
+	if _, _, err := client.Transaction.Initialize(context.Background(), tr); err != nil {
+		t.Fatalf("Transaction.Initialize returned error: %v", err)
+	}
+	if tr.GetReference() != "ref-1" {
+		t.Errorf("TransactionRequest.Reference = %q, want ref-1", tr.GetReference())
+	}
+}
+
+func TestTransactionService_ChargeAuthorizationOnce_verifiesLostCharge(t *testing.T) {
+	setup()
+	defer teardown()
+
+	charges := 0
+	mux.HandleFunc("/transaction/charge_authorization", func(w http.ResponseWriter, r *http.Request) {
+		charges++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	mux.HandleFunc("/transaction/verify/T1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": true, "message": "Verification successful", "data": {"reference": "T1", "status": "success", "plan": "PLN_gx2wn530m0i3w3m"}}`)
+	})
+
+	tr, _, err := client.Transaction.ChargeAuthorizationOnce(context.Background(), &TransactionRequest{Reference: String("T1")})
+	if err != nil {
+		t.Fatalf("Transaction.ChargeAuthorizationOnce returned error: %v", err)
+	}
+	if charges != 1 || tr.GetStatus() != "success" || tr.Plan.GetPlanCode() != "PLN_gx2wn530m0i3w3m" {
+		t.Errorf("Transaction.ChargeAuthorizationOnce charged %d times and returned %+v", charges, tr)
+	}
+}
+
+func TestTransactionService_ChargeAuthorizationOnce_resubmitsUnprocessed(t *testing.T) {
+	setup()
+	defer teardown()
+	Retry(RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond})(client)
+
+	var references []string
+	mux.HandleFunc("/transaction/charge_authorization", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		references = append(references, body["reference"].(string))
+		if len(references) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintf(w, `{"status": true, "message": "Charge attempted", "data": {"reference": %q, "status": "success"}}`, body["reference"])
+	})
+	mux.HandleFunc("/transaction/verify/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"status": false, "message": "Transaction reference not found"}`)
+	})
+
+	req := &TransactionRequest{Email: String("bojack@horsinaround.com"), Amount: NewMoney(100, NGN)}
+	tr, _, err := client.Transaction.ChargeAuthorizationOnce(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Transaction.ChargeAuthorizationOnce returned error: %v", err)
+	}
+	if len(references) != 2 || references[0] != references[1] || references[0] != req.GetReference() {
+		t.Errorf("Transaction.ChargeAuthorizationOnce sent references %v, want the generated reference twice", references)
+	}
+	if tr.GetReference() != req.GetReference() || tr.GetStatus() != "success" {
+		t.Errorf("Transaction.ChargeAuthorizationOnce returned %+v", tr)
+	}
+}
+
+func TestTransactionService_ChargeAuthorizationOnce_outcomeUnknown(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transaction/charge_authorization", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	mux.HandleFunc("/transaction/verify/T1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := client.Transaction.ChargeAuthorizationOnce(context.Background(), &TransactionRequest{Reference: String("T1")})
+	var se *ServerError
+	if !errors.Is(err, ErrOutcomeUnknown) || !errors.As(err, &se) {
+		t.Errorf("Transaction.ChargeAuthorizationOnce returned %v, want ErrOutcomeUnknown wrapping a ServerError", err)
+	}
+}
+
+func TestTransactionService_ChargeAuthorizationOnce_rejected(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/transaction/charge_authorization", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"status": false, "message": "Duplicate Transaction Reference"}`)
+	})
+
+	_, _, err := client.Transaction.ChargeAuthorizationOnce(context.Background(), &TransactionRequest{Reference: String("T1")})
+	if !errors.Is(err, ErrDuplicateReference) || calls != 1 {
+		t.Errorf("Transaction.ChargeAuthorizationOnce returned %v after %d calls, want ErrDuplicateReference after 1", err, calls)
+	}
+}
+
+func TestTransferService_InitiateOnce_resubmitsUnprocessed(t *testing.T) {
+	setup()
+	defer teardown()
+	Retry(RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond})(client)
+
+	var references []string
+	mux.HandleFunc("/transfer", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		references = append(references, body["reference"].(string))
+		if len(references) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintf(w, `{"status": true, "message": "Transfer requires OTP to continue", "data": {"reference": %q, "status": "otp"}}`, body["reference"])
+	})
+	mux.HandleFunc("/transfer/verify/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"status": false, "message": "Transfer not found"}`)
+	})
+
+	req := &TransferRequest{Recipient: String("RCP_1"), Amount: NewMoney(100, NGN)}
+	tr, _, err := client.Transfer.InitiateOnce(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Transfer.InitiateOnce returned error: %v", err)
+	}
+	if len(references) != 2 || references[0] != references[1] || references[0] != req.GetReference() {
+		t.Errorf("Transfer.InitiateOnce sent references %v, want the generated reference twice", references)
+	}
+	if tr.GetReference() != req.GetReference() || tr.GetStatus() != "otp" {
+		t.Errorf("Transfer.InitiateOnce returned %+v", tr)
+	}
+}
+
+func TestTransferService_InitiateOnce_outcomeUnknown(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transfer", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	mux.HandleFunc("/transfer/verify/TRF_ref", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := client.Transfer.InitiateOnce(context.Background(), &TransferRequest{Reference: String("TRF_ref")})
+	var se *ServerError
+	if !errors.Is(err, ErrOutcomeUnknown) || !errors.As(err, &se) {
+		t.Errorf("Transfer.InitiateOnce returned %v, want ErrOutcomeUnknown wrapping a ServerError", err)
+	}
+}
+
+func TestTransferService_InitiateOnce_rejected(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/transfer", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"status": false, "message": "Your balance is not enough to fulfil this request"}`)
+	})
+
+	_, _, err := client.Transfer.InitiateOnce(context.Background(), &TransferRequest{Reference: String("TRF_ref")})
+	if !errors.Is(err, ErrInsufficientBalance) || calls != 1 {
+		t.Errorf("Transfer.InitiateOnce returned %v after %d calls, want ErrInsufficientBalance after 1", err, calls)
+	}
+}
+
+func TestOnce_nilRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, _, err := client.Transfer.InitiateOnce(ctx, nil); err == nil {
+		t.Error("Transfer.InitiateOnce with a nil request returned no error")
+	}
+	if _, _, err := client.Transaction.ChargeAuthorizationOnce(ctx, nil); err == nil {
+		t.Error("Transaction.ChargeAuthorizationOnce with a nil request returned no error")
+	}
+}
@@ -0,0 +1,127 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// RefundService handles the communication with the Refunds related parts of the Paystack API
+type RefundService service
+
+type Refund struct {
+	Transaction    Transaction `json:"transaction,omitempty"`
+	Integration    *int        `json:"integration,omitempty"`
+	Domain         *string     `json:"domain,omitempty"`
+	Amount         *Money      `json:"amount,omitempty"`
+	DeductedAmount *Money      `json:"deducted_amount,omitempty"`
+	FullyDeducted  *bool       `json:"fully_deducted,omitempty"`
+	Currency       *Currency   `json:"currency,omitempty"`
+	Channel        *string     `json:"channel,omitempty"`
+	Status         *string     `json:"status,omitempty"`
+	RefundedBy     *string     `json:"refunded_by,omitempty"`
+	CustomerNote   *string     `json:"customer_note,omitempty"`
+	MerchantNote   *string     `json:"merchant_note,omitempty"`
+	ExpectedAt     *time.Time  `json:"expected_at,omitempty"`
+	RefundedAt     *time.Time  `json:"refunded_at,omitempty"`
+	Id             *int        `json:"id,omitempty"`
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+}
+
+// RefundRequest creates a refund. Amount is in the minor unit and may be left
+// nil to refund the whole transaction.
+type RefundRequest struct {
+	Transaction  *string   `json:"transaction,omitempty"`
+	Amount       *Money    `json:"amount,omitempty"`
+	Currency     *Currency `json:"currency,omitempty"`
+	CustomerNote *string   `json:"customer_note,omitempty"`
+	MerchantNote *string   `json:"merchant_note,omitempty"`
+}
+
+// RefundOptions specifies the optional parameters to RefundService.List
+type RefundOptions struct {
+	ListOptions
+
+	// Transaction filters refunds by transaction id or reference.
+	Transaction string `url:"transaction,omitempty"`
+
+	// Currency filters refunds by currency.
+	Currency Currency `url:"currency,omitempty"`
+
+	// From and To filter refunds by creation date.
+	From time.Time `url:"from,omitempty"`
+	To   time.Time `url:"to,omitempty"`
+}
+
+// Create refunds a transaction, in full or in part
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#create-refund
+func (s *RefundService) Create(ctx context.Context, rr *RefundRequest) (*Refund, *Response, error) {
+	u := fmt.Sprintf("refund")
+	req, err := s.client.NewRequest("POST", u, rr)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Refund])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// List returns the refunds available on the integration
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#list-refunds
+func (s *RefundService) List(ctx context.Context, opt *RefundOptions) ([]Refund, *Response, error) {
+	u := fmt.Sprintf("refund")
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	lr := new(Envelope[[]Refund])
+	resp, err := s.client.Do(ctx, req, lr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lr.Data, resp, nil
+}
+
+// ListAll iterates over all refunds, calling List for each page
+func (s *RefundService) ListAll(ctx context.Context, opt *RefundOptions, iopt *IterOptions) iter.Seq2[Refund, error] {
+	var o RefundOptions
+	if opt != nil {
+		o = *opt
+	}
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]Refund, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
+}
+
+// Fetch returns the details of a refund
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#fetch-refund
+func (s *RefundService) Fetch(ctx context.Context, id string) (*Refund, *Response, error) {
+	u := fmt.Sprintf("refund/" + id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Refund])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
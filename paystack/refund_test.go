@@ -0,0 +1,144 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRefundService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/refund", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		want := map[string]interface{}{"transaction": "T685312322670591", "amount": float64(10000), "currency": "NGN", "merchant_note": "Damaged item"}
+		if !cmp.Equal(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Refund has been queued for processing",
+		  "data": {
+			"transaction": {
+			  "id": 1004723697,
+			  "reference": "T685312322670591",
+			  "amount": 10000,
+			  "currency": "NGN"
+			},
+			"integration": 412829,
+			"deducted_amount": 0,
+			"channel": null,
+			"merchant_note": "Damaged item",
+			"customer_note": "Refund for transaction T685312322670591",
+			"status": "pending",
+			"refunded_by": "admin@example.com",
+			"currency": "NGN",
+			"domain": "test",
+			"amount": 10000,
+			"fully_deducted": false,
+			"id": 1
+		  }
+		}`)
+	})
+
+	refund, _, err := client.Refund.Create(context.Background(), &RefundRequest{
+		Transaction:  String("T685312322670591"),
+		Amount:       NewMoney(10000, NGN),
+		MerchantNote: String("Damaged item"),
+	})
+	if err != nil {
+		t.Errorf("Refund.Create returned error: %v", err)
+	}
+
+	want := &Refund{
+		Transaction:    Transaction{Id: Int(1004723697), Reference: String("T685312322670591"), Amount: NewMoney(10000, NGN), Currency: NGN.Ptr()},
+		Integration:    Int(412829),
+		DeductedAmount: NewMoney(0, NGN),
+		MerchantNote:   String("Damaged item"),
+		CustomerNote:   String("Refund for transaction T685312322670591"),
+		Status:         String("pending"),
+		RefundedBy:     String("admin@example.com"),
+		Currency:       NGN.Ptr(),
+		Domain:         String("test"),
+		Amount:         NewMoney(10000, NGN),
+		FullyDeducted:  Bool(false),
+		Id:             Int(1),
+	}
+	if !cmp.Equal(refund, want) {
+		t.Errorf("Refund.Create returned %+v, want %+v", refund, want)
+	}
+}
+
+func TestRefundService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/refund", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"transaction": "1004723697", "currency": "NGN", "page": "2"})
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Refunds retrieved",
+		  "data": [
+			{
+			  "integration": 412829,
+			  "transaction": 1004723697,
+			  "currency": "NGN",
+			  "amount": 10000,
+			  "status": "processed",
+			  "id": 1
+			}
+		  ],
+		  "meta": {"total": 1, "skipped": 0, "perPage": 50, "page": 2, "pageCount": 1}
+		}`)
+	})
+
+	opt := &RefundOptions{ListOptions: ListOptions{Page: 2}, Transaction: "1004723697", Currency: "NGN"}
+	refunds, _, err := client.Refund.List(context.Background(), opt)
+	if err != nil {
+		t.Errorf("Refund.List returned error: %v", err)
+	}
+
+	want := []Refund{{
+		Integration: Int(412829),
+		Transaction: Transaction{Id: Int(1004723697)},
+		Currency:    NGN.Ptr(),
+		Amount:      NewMoney(10000, NGN),
+		Status:      String("processed"),
+		Id:          Int(1),
+	}}
+	if !cmp.Equal(refunds, want) {
+		t.Errorf("Refund.List returned %+v, want %+v", refunds, want)
+	}
+}
+
+func TestRefundService_Fetch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/refund/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Refund retrieved",
+		  "data": {"transaction": 1004723697, "amount": 10000, "status": "processed", "id": 1}
+		}`)
+	})
+
+	refund, _, err := client.Refund.Fetch(context.Background(), "1")
+	if err != nil {
+		t.Errorf("Refund.Fetch returned error: %v", err)
+	}
+
+	want := &Refund{Transaction: Transaction{Id: Int(1004723697)}, Amount: &Money{Amount: 10000}, Status: String("processed"), Id: Int(1)}
+	if !cmp.Equal(refund, want) {
+		t.Errorf("Refund.Fetch returned %+v, want %+v", refund, want)
+	}
+}
@@ -0,0 +1,178 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultRetryMinBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy configures how Client.Do retries failed requests.
+// Only safe requests (GET, HEAD and OPTIONS) are retried unless
+// RetryNonIdempotent is set, or the request context was created with
+// WithNonIdempotentRetry.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt.
+	MaxRetries int
+
+	// MinBackoff is the base delay used for the exponential backoff.
+	// Defaults to 500ms when zero.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the delay between two attempts, excluding delays
+	// requested by the server through the Retry-After header.
+	// Defaults to 30s when zero.
+	MaxBackoff time.Duration
+
+	// RetryNonIdempotent allows POST, PUT, PATCH and DELETE requests such
+	// as Transfer.Initiate or Charge.Charge to be replayed. Replaying them
+	// may cause a payment to be made twice, and Paystack does not promise
+	// that its PUT and DELETE endpoints are idempotent either.
+	RetryNonIdempotent bool
+}
+
+// Retry is a referential function that sets the retry policy of the
+// client when initializing it
+func Retry(p RetryPolicy) func(*Client) {
+	return func(c *Client) {
+		c.retry = &p
+	}
+}
+
+type nonIdempotentRetryKey struct{}
+
+// WithNonIdempotentRetry returns a copy of ctx that allows Client.Do to
+// replay non-idempotent requests made with it. Use it only for calls that
+// are safe to repeat, for instance a transaction initialization with a
+// caller supplied reference.
+func WithNonIdempotentRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, nonIdempotentRetryKey{}, true)
+}
+
+// canRetry reports whether req may be sent again under policy p.
+func (p *RetryPolicy) canRetry(ctx context.Context, req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS":
+		return true
+	}
+	if p.RetryNonIdempotent {
+		return true
+	}
+	ok, _ := ctx.Value(nonIdempotentRetryKey{}).(bool)
+	return ok
+}
+
+// backoff returns the delay before retry number n (starting at 0) using
+// exponential backoff with full jitter.
+func (p *RetryPolicy) backoff(n int) time.Duration {
+	min, max := p.MinBackoff, p.MaxBackoff
+	if min <= 0 {
+		min = defaultRetryMinBackoff
+	}
+	if max <= 0 {
+		max = defaultRetryMaxBackoff
+	}
+	d := min
+	for i := 0; i < n && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return time.Duration(rand.Int63n(int64(d)) + 1)
+}
+
+// shouldRetry reports whether an attempt that returned resp and err is
+// worth retrying. resp may be nil when the request failed in transport.
+func shouldRetry(resp *Response, err error) bool {
+	if resp == nil {
+		return isRetryableError(err)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isRetryableError reports whether err is a transport error such as a
+// connection reset that may succeed when retried.
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+	var ne net.Error
+	return errors.As(err, &ne) && ne.Timeout()
+}
+
+// retryAfter parses the Retry-After header of r, which may hold either a
+// number of seconds or an HTTP date. It returns 0 if the header is absent
+// or invalid.
+func retryAfter(r *Response) time.Duration {
+	if r == nil || r.Response == nil {
+		return 0
+	}
+	v := r.Header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if s, err := strconv.Atoi(v); err == nil && s > 0 {
+		return time.Duration(s) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// rewindBody resets the body of req so that it can be sent again.
+func rewindBody(req *http.Request) error {
+	if req.GetBody == nil || req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+// sleepCtx waits for d, returning false without waiting if ctx would
+// expire first, or as soon as ctx is done.
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return false
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
@@ -0,0 +1,168 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDo_retriesIdempotentRequests(t *testing.T) {
+	setup()
+	defer teardown()
+	Retry(RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond})(client)
+
+	calls := 0
+	mux.HandleFunc("/balance", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"status": true, "message": "Balances retrieved", "data": []}`)
+	})
+
+	_, resp, err := client.Balance.Check(context.Background())
+	if err != nil {
+		t.Fatalf("Balance.Check returned error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("server received %d calls, want 3", calls)
+	}
+	if resp.Attempts != 3 {
+		t.Errorf("Response.Attempts = %d, want 3", resp.Attempts)
+	}
+	if resp.RetryWait <= 0 {
+		t.Errorf("Response.RetryWait = %v, want > 0", resp.RetryWait)
+	}
+}
+
+func TestDo_givesUpAfterMaxRetries(t *testing.T) {
+	setup()
+	defer teardown()
+	Retry(RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})(client)
+
+	calls := 0
+	mux.HandleFunc("/balance", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, resp, err := client.Balance.Check(context.Background())
+	if _, ok := err.(*ServerError); !ok {
+		t.Fatalf("Balance.Check returned error %#v, want *ServerError", err)
+	}
+	if calls != 3 || resp.Attempts != 3 {
+		t.Errorf("got %d calls and %d attempts, want 3", calls, resp.Attempts)
+	}
+}
+
+func TestDo_doesNotReplayPost(t *testing.T) {
+	setup()
+	defer teardown()
+	Retry(RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond})(client)
+
+	calls := 0
+	mux.HandleFunc("/transfer", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, _, err := client.Transfer.Initiate(context.Background(), &TransferRequest{Amount: &Money{Amount: 100}})
+	if err == nil {
+		t.Fatal("Transfer.Initiate returned nil error")
+	}
+	if calls != 1 {
+		t.Errorf("server received %d calls, want 1", calls)
+	}
+}
+
+func TestDo_doesNotReplayPut(t *testing.T) {
+	setup()
+	defer teardown()
+	Retry(RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond})(client)
+
+	calls := 0
+	mux.HandleFunc("/customer/CUS_xnxdt6s1zg1f4nx", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := client.Customer.Update(context.Background(), &CustomerRequest{FirstName: String("Bojack")}, "CUS_xnxdt6s1zg1f4nx")
+	if err == nil {
+		t.Fatal("Customer.Update returned nil error")
+	}
+	if calls != 1 {
+		t.Errorf("server received %d calls, want 1", calls)
+	}
+}
+
+func TestDo_replaysPostWhenOptedIn(t *testing.T) {
+	setup()
+	defer teardown()
+	Retry(RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond})(client)
+
+	calls := 0
+	mux.HandleFunc("/transfer", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := ioutil.ReadAll(r.Body)
+		if want := `{"recipient":null,"amount":100,"currency":null,"source":null,"reason":null,"transfer_code":null}` + "\n"; string(body) != want {
+			t.Errorf("attempt %d sent body %s, want %s", calls, body, want)
+		}
+		if calls == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"status": true, "message": "Transfer requires OTP to continue", "data": {"transfer_code": "TRF_1ptvuv321ahaa7q"}}`)
+	})
+
+	ctx := WithNonIdempotentRetry(context.Background())
+	_, resp, err := client.Transfer.Initiate(ctx, &TransferRequest{Amount: &Money{Amount: 100}})
+	if err != nil {
+		t.Fatalf("Transfer.Initiate returned error: %v", err)
+	}
+	if calls != 2 || resp.Attempts != 2 {
+		t.Errorf("got %d calls and %d attempts, want 2", calls, resp.Attempts)
+	}
+}
+
+func TestDo_honoursRetryAfterAndDeadline(t *testing.T) {
+	setup()
+	defer teardown()
+	Retry(RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond})(client)
+
+	calls := 0
+	mux.HandleFunc("/balance", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	start := time.Now()
+	_, _, err := client.Balance.Check(ctx)
+	if err == nil {
+		t.Fatal("Balance.Check returned nil error")
+	}
+	if calls != 1 {
+		t.Errorf("server received %d calls, want 1", calls)
+	}
+	if d := time.Since(start); d > 500*time.Millisecond {
+		t.Errorf("Balance.Check waited %v, want it to give up before the deadline", d)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 10 * time.Millisecond, MaxBackoff: 40 * time.Millisecond}
+	for n := 0; n < 10; n++ {
+		if d := p.backoff(n); d <= 0 || d > 40*time.Millisecond {
+			t.Errorf("backoff(%d) = %v, want within (0, 40ms]", n, d)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package paystack
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 )
 
@@ -10,42 +11,107 @@ import (
 type SettlementService service
 
 type Settlement struct {
-	Integration *int        `json:"integration, omitempty"`
-	Subaccount  Subaccount  `json:"subaccount, omitempty"`
-	SettledBy   interface{} `json:"settled_by, omitempty"`
-	SettledDate *time.Time  `json:"settled_date, omitempty"`
-	Domain      *string     `json:"domain, omitempty"`
-	TotalAmount *int        `json:"total_amount, omitempty"`
-	Status      *string     `json:"status, omitempty"`
-	Id          *int        `json:"id, omitempty"`
-	CreatedAt   *time.Time  `json:"created_at, omitempty"`
-	UpdatedAt   *time.Time  `json:"updated_at, omitempty"`
+	Integration     *int        `json:"integration, omitempty"`
+	Subaccount      Subaccount  `json:"subaccount, omitempty"`
+	SettledBy       interface{} `json:"settled_by, omitempty"`
+	SettledDate     *time.Time  `json:"settled_date, omitempty"`
+	SettlementDate  *time.Time  `json:"settlement_date,omitempty"`
+	Domain          *string     `json:"domain, omitempty"`
+	Currency        *Currency   `json:"currency,omitempty"`
+	TotalAmount     *Money      `json:"total_amount, omitempty"`
+	TotalFees       *Money      `json:"total_fees,omitempty"`
+	TotalProcessed  *Money      `json:"total_processed,omitempty"`
+	EffectiveAmount *Money      `json:"effective_amount,omitempty"`
+	Status          *string     `json:"status, omitempty"`
+	Id              *int        `json:"id, omitempty"`
+	CreatedAt       *time.Time  `json:"created_at, omitempty"`
+	UpdatedAt       *time.Time  `json:"updated_at, omitempty"`
 }
 
-// Fetch gets all settlements made to your bank accounts
+// SettlementTransactionOptions specifies the optional parameters to
+// SettlementService.ListTransactions
+type SettlementTransactionOptions struct {
+	ListOptions
+	From time.Time `url:"from,omitempty"`
+	To   time.Time `url:"to,omitempty"`
+}
+
+// List returns the settlements made to your bank accounts
 //
 // Paystack API reference:
 // https://developers.paystack.co/reference#fetch-settlements
-func (s *SettlementService) Fetch(ctx context.Context, opt *SettlementOptions) ([]Settlement, *Response, error) {
+func (s *SettlementService) List(ctx context.Context, opt *SettlementOptions) ([]Settlement, *Response, error) {
 	u := fmt.Sprintf("settlement")
-	//Response is erroneous if opt.Page or opt.PerPage = 0
 	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	lr := new(StandardListResponse)
+	lr := new(Envelope[[]Settlement])
 	resp, err := s.client.Do(ctx, req, lr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lr.Data, resp, nil
+}
+
+// ListAll iterates over all settlements, calling List for each page
+func (s *SettlementService) ListAll(ctx context.Context, opt *SettlementOptions, iopt *IterOptions) iter.Seq2[Settlement, error] {
+	var o SettlementOptions
+	if opt != nil {
+		o = *opt
+	}
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]Settlement, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
+}
 
+// Fetch gets all settlements made to your bank accounts
+//
+// Deprecated: use List, or ListAll to follow the pages.
+func (s *SettlementService) Fetch(ctx context.Context, opt *SettlementOptions) ([]Settlement, *Response, error) {
+	return s.List(ctx, opt)
+}
+
+// ListTransactions returns the transactions paid out in a settlement
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#settlement-transactions
+func (s *SettlementService) ListTransactions(ctx context.Context, id string, opt *SettlementTransactionOptions) ([]Transaction, *Response, error) {
+	u := fmt.Sprintf("settlement/%s/transactions", id)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lr := new(Envelope[[]Transaction])
+	resp, err := s.client.Do(ctx, req, lr)
 	if err != nil {
 		return nil, resp, err
 	}
-	var sa []Settlement
-	c := new(Settlement)
-	for _, x := range lr.Data {
-		mapDecoder(x, c)
-		sa = append(sa, *c)
+	return lr.Data, resp, nil
+}
+
+// ListAllTransactions iterates over all transactions of a settlement, calling
+// ListTransactions for each page
+func (s *SettlementService) ListAllTransactions(ctx context.Context, id string, opt *SettlementTransactionOptions, iopt *IterOptions) iter.Seq2[Transaction, error] {
+	var o SettlementTransactionOptions
+	if opt != nil {
+		o = *opt
 	}
-	return sa, resp, nil
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]Transaction, *Response, error) {
+		o := o
+		o.Page = page
+		return s.ListTransactions(ctx, id, &o)
+	}, iopt)
 }
@@ -0,0 +1,144 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ReconcileOptions configures Reconcile.
+type ReconcileOptions struct {
+	// RefundsFrom and RefundsTo bound the creation dates of the refunds
+	// considered, by default the day before the settlement date.
+	RefundsFrom time.Time
+	RefundsTo   time.Time
+}
+
+// SettlementReconciliation compares the total amount of a settlement with
+// the amount expected from its transactions and refunds.
+type SettlementReconciliation struct {
+	Settlement   *Settlement
+	Transactions []Transaction
+
+	// Refunds are the refunds of transactions of the settlement, and
+	// UnmatchedRefunds the other refunds of the period, which may have been
+	// deducted from the settlement or from another one.
+	Refunds          []Refund
+	UnmatchedRefunds []Refund
+
+	// Gross is the sum of the amounts of the transactions, Fees the sum of
+	// their fees, Refunded the sum of Refunds and Unmatched the sum of
+	// UnmatchedRefunds.
+	Gross     Money
+	Fees      Money
+	Refunded  Money
+	Unmatched Money
+
+	// Expected is Gross minus Fees and Refunded, and Difference is the
+	// total amount of the settlement minus Expected.
+	Expected   Money
+	Difference Money
+}
+
+// Balanced reports whether the settlement matches its transactions and
+// refunds.
+func (r *SettlementReconciliation) Balanced() bool {
+	return r.Difference.IsZero()
+}
+
+// Reconcile sums the transactions of a settlement minus their fees and
+// refunds, and compares the result with the total amount of the settlement.
+// A non-zero Difference flags a settlement to look into. opt may be nil.
+//
+// Paystack does not tell which settlement a refund was deducted from, so
+// Reconcile lists the refunds in the currency of the settlement created in
+// the period of opt, and only subtracts those of the transactions of the
+// settlement, matched by transaction reference or id. The others are
+// reported in UnmatchedRefunds: a Difference of minus Unmatched suggests
+// they were deducted from this settlement. Refunds are counted for their
+// deducted amount, or their amount when it is unset, unless they failed.
+func (s *SettlementService) Reconcile(ctx context.Context, st *Settlement, opt *ReconcileOptions) (*SettlementReconciliation, error) {
+	var o ReconcileOptions
+	if opt != nil {
+		o = *opt
+	}
+	currency := st.GetCurrency()
+	if o.RefundsFrom.IsZero() && o.RefundsTo.IsZero() {
+		date := st.SettlementDate
+		if date == nil {
+			date = st.SettledDate
+		}
+		if date == nil {
+			return nil, fmt.Errorf("paystack: settlement %d has no settlement date", st.GetId())
+		}
+		o.RefundsFrom, o.RefundsTo = date.Add(-24*time.Hour), *date
+	}
+
+	r := &SettlementReconciliation{Settlement: st}
+	r.Gross.Currency, r.Fees.Currency = currency, currency
+	r.Refunded.Currency, r.Unmatched.Currency = currency, currency
+	refs, ids := make(map[string]bool), make(map[int]bool)
+	var err error
+	id := strconv.Itoa(st.GetId())
+	page := &SettlementTransactionOptions{ListOptions: ListOptions{PerPage: 100}}
+	for t, terr := range s.ListAllTransactions(ctx, id, page, nil) {
+		if terr != nil {
+			return nil, terr
+		}
+		if r.Gross, err = r.Gross.Add(t.GetAmount()); err != nil {
+			return nil, err
+		}
+		if r.Fees, err = r.Fees.Add(t.GetFees()); err != nil {
+			return nil, err
+		}
+		if t.Reference != nil {
+			refs[t.GetReference()] = true
+		}
+		if t.Id != nil {
+			ids[t.GetId()] = true
+		}
+		r.Transactions = append(r.Transactions, t)
+	}
+
+	refunds := &RefundOptions{
+		ListOptions: ListOptions{PerPage: 100},
+		Currency:    currency,
+		From:        o.RefundsFrom,
+		To:          o.RefundsTo,
+	}
+	for rf, rerr := range s.client.Refund.ListAll(ctx, refunds, nil) {
+		if rerr != nil {
+			return nil, rerr
+		}
+		if rf.GetStatus() == "failed" {
+			continue
+		}
+		amount := rf.GetAmount()
+		if rf.DeductedAmount != nil {
+			amount = rf.GetDeductedAmount()
+		}
+		if t := rf.Transaction; t.Reference != nil && refs[t.GetReference()] || t.Id != nil && ids[t.GetId()] {
+			if r.Refunded, err = r.Refunded.Add(amount); err != nil {
+				return nil, err
+			}
+			r.Refunds = append(r.Refunds, rf)
+			continue
+		}
+		if r.Unmatched, err = r.Unmatched.Add(amount); err != nil {
+			return nil, err
+		}
+		r.UnmatchedRefunds = append(r.UnmatchedRefunds, rf)
+	}
+
+	if r.Expected, err = r.Gross.Sub(r.Fees); err != nil {
+		return nil, err
+	}
+	if r.Expected, err = r.Expected.Sub(r.Refunded); err != nil {
+		return nil, err
+	}
+	if r.Difference, err = st.GetTotalAmount().Sub(r.Expected); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,132 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSettlementService_ListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/settlement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		page := r.FormValue("page")
+		testFormValues(t, r, values{"page": page, "perPage": "1", "status": "success", "from": "2024-01-01T00:00:00Z"})
+		fmt.Fprintf(w, `{
+		  "status": true,
+		  "message": "Settlements retrieved",
+		  "data": [{"id": %s, "currency": "NGN", "total_amount": 150000, "status": "success"}],
+		  "meta": {"total": 2, "skipped": 0, "perPage": 1, "page": %s, "pageCount": 2}
+		}`, page, page)
+	})
+
+	opt := &SettlementOptions{
+		ListOptions: ListOptions{PerPage: 1},
+		Status:      "success",
+		From:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	var ids []int
+	for st, err := range client.Settlement.ListAll(context.Background(), opt, nil) {
+		if err != nil {
+			t.Fatalf("Settlement.ListAll returned error: %v", err)
+		}
+		if st.GetTotalAmount() != (Money{150000, NGN}) {
+			t.Errorf("Settlement.ListAll returned total amount %v, want NGN 1500.00", st.GetTotalAmount())
+		}
+		ids = append(ids, st.GetId())
+	}
+	if !cmp.Equal(ids, []int{1, 2}) {
+		t.Errorf("Settlement.ListAll returned settlements %v, want [1 2]", ids)
+	}
+}
+
+func TestSettlementService_ListTransactions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/settlement/3080/transactions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"perPage": "50"})
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Transactions retrieved",
+		  "data": [{"id": 1, "reference": "T1", "amount": 100000, "fees": 1500, "currency": "GHS"}],
+		  "meta": {"total": 1, "skipped": 0, "perPage": 50, "page": 1, "pageCount": 1}
+		}`)
+	})
+
+	ts, _, err := client.Settlement.ListTransactions(context.Background(), "3080", &SettlementTransactionOptions{ListOptions: ListOptions{PerPage: 50}})
+	if err != nil {
+		t.Fatalf("Settlement.ListTransactions returned error: %v", err)
+	}
+	if len(ts) != 1 || ts[0].GetReference() != "T1" || ts[0].GetFees() != (Money{1500, GHS}) {
+		t.Errorf("Settlement.ListTransactions returned %+v", ts)
+	}
+}
+
+func TestSettlementService_Reconcile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/settlement/3080/transactions", func(w http.ResponseWriter, r *http.Request) {
+		data := `[{"id": 1, "reference": "T1", "amount": 100000, "fees": 1500, "currency": "NGN"},
+			{"reference": "T2", "amount": 50000, "fees": 750, "currency": "NGN"}]`
+		if r.FormValue("page") == "2" {
+			data = `[{"reference": "T3", "amount": 20000, "fees": 300, "currency": "NGN"}]`
+		}
+		fmt.Fprintf(w, `{"status": true, "message": "Transactions retrieved", "data": %s,
+		  "meta": {"total": 3, "perPage": 2, "page": %s, "pageCount": 2}}`, data, r.FormValue("page"))
+	})
+	mux.HandleFunc("/refund", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{
+			"page": "1", "perPage": "100", "currency": "NGN",
+			"from": "2024-03-01T09:00:00Z", "to": "2024-03-02T09:00:00Z",
+		})
+		fmt.Fprint(w, `{"status": true, "message": "Refunds retrieved", "data": [
+		  {"id": 1, "transaction": {"reference": "T1"}, "amount": 10000, "deducted_amount": 10000, "currency": "NGN", "status": "processed"},
+		  {"id": 2, "transaction": 9001, "amount": 5000, "currency": "NGN", "status": "pending"},
+		  {"id": 3, "transaction": {"reference": "T2"}, "amount": 7000, "currency": "NGN", "status": "failed"}
+		], "meta": {"total": 3, "perPage": 100, "page": 1, "pageCount": 1}}`)
+	})
+
+	date := time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC)
+	st := &Settlement{
+		Id:             Int(3080),
+		Currency:       NGN.Ptr(),
+		TotalAmount:    NewMoney(152450, NGN),
+		SettlementDate: &date,
+	}
+	r, err := client.Settlement.Reconcile(context.Background(), st, nil)
+	if err != nil {
+		t.Fatalf("Settlement.Reconcile returned error: %v", err)
+	}
+	got := map[string]Money{
+		"gross": r.Gross, "fees": r.Fees, "refunded": r.Refunded, "unmatched": r.Unmatched,
+		"expected": r.Expected, "difference": r.Difference,
+	}
+	want := map[string]Money{
+		"gross":      {170000, NGN},
+		"fees":       {2550, NGN},
+		"refunded":   {10000, NGN},
+		"unmatched":  {5000, NGN},
+		"expected":   {157450, NGN},
+		"difference": {-5000, NGN},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Settlement.Reconcile returned %v, want %v", got, want)
+	}
+	if r.Balanced() || len(r.Transactions) != 3 || len(r.Refunds) != 1 || len(r.UnmatchedRefunds) != 1 {
+		t.Errorf("Settlement.Reconcile returned balanced %v with %d transactions, %d refunds and %d unmatched, want false, 3, 1 and 1",
+			r.Balanced(), len(r.Transactions), len(r.Refunds), len(r.UnmatchedRefunds))
+	}
+
+	if _, err := client.Settlement.Reconcile(context.Background(), &Settlement{Id: Int(1)}, nil); err == nil {
+		t.Error("Settlement.Reconcile of a settlement without a date returned no error")
+	}
+}
@@ -0,0 +1,300 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// SplitService handles the communication with the Transaction Splits related parts of the Paystack API
+type SplitService service
+
+// SplitType enumerates how the shares of a split are expressed
+type SplitType int
+
+const (
+	// SplitPercentage shares are percentages of the transaction amount.
+	SplitPercentage SplitType = 1 + iota
+	// SplitFlat shares are fixed amounts in the minor unit.
+	SplitFlat
+)
+
+var splitTypes = [...]string{
+	"percentage",
+	"flat",
+}
+
+// String returns the API name of a SplitType, or "" if it is unset
+func (t SplitType) String() string { return enumString(splitTypes[:], int(t)) }
+
+func (t SplitType) MarshalText() ([]byte, error) { return []byte(t.String()), nil }
+
+func (t *SplitType) UnmarshalText(text []byte) error {
+	*t = SplitType(enumParse(splitTypes[:], string(text)))
+	return nil
+}
+
+// SplitBearerType enumerates who bears the Paystack fees of a split
+type SplitBearerType int
+
+const (
+	SplitBearerAccount SplitBearerType = 1 + iota
+	SplitBearerSubaccount
+	SplitBearerAllProportional
+	SplitBearerAll
+)
+
+var splitBearerTypes = [...]string{
+	"account",
+	"subaccount",
+	"all-proportional",
+	"all",
+}
+
+// String returns the API name of a SplitBearerType, or "" if it is unset
+func (t SplitBearerType) String() string { return enumString(splitBearerTypes[:], int(t)) }
+
+func (t SplitBearerType) MarshalText() ([]byte, error) { return []byte(t.String()), nil }
+
+func (t *SplitBearerType) UnmarshalText(text []byte) error {
+	*t = SplitBearerType(enumParse(splitBearerTypes[:], string(text)))
+	return nil
+}
+
+type Split struct {
+	Id               *int              `json:"id,omitempty"`
+	Name             *string           `json:"name,omitempty"`
+	Type             SplitType         `json:"type,omitempty"`
+	Currency         *Currency         `json:"currency,omitempty"`
+	Integration      *int              `json:"integration,omitempty"`
+	Domain           *string           `json:"domain,omitempty"`
+	SplitCode        *string           `json:"split_code,omitempty"`
+	Active           *bool             `json:"active,omitempty"`
+	BearerType       SplitBearerType   `json:"bearer_type,omitempty"`
+	BearerSubaccount *int              `json:"bearer_subaccount,omitempty"`
+	IsDynamic        *bool             `json:"is_dynamic,omitempty"`
+	Subaccounts      []SplitSubaccount `json:"subaccounts,omitempty"`
+	TotalSubaccounts *int              `json:"total_subaccounts,omitempty"`
+	CreatedAt        *time.Time        `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time        `json:"updatedAt,omitempty"`
+}
+
+// SplitSubaccount is the share of a subaccount in a split
+type SplitSubaccount struct {
+	Subaccount Subaccount `json:"subaccount,omitempty"`
+	Share      *int       `json:"share,omitempty"`
+}
+
+// SplitShare is the share given to a subaccount when creating a split or
+// adding a subaccount to it. Subaccount is the subaccount code.
+type SplitShare struct {
+	Subaccount *string `json:"subaccount,omitempty"`
+	Share      *int    `json:"share,omitempty"`
+}
+
+type SplitRequest struct {
+	Name             *string         `json:"name,omitempty"`
+	Type             SplitType       `json:"type,omitempty"`
+	Currency         *Currency       `json:"currency,omitempty"`
+	Subaccounts      []SplitShare    `json:"subaccounts,omitempty"`
+	BearerType       SplitBearerType `json:"bearer_type,omitempty"`
+	BearerSubaccount *string         `json:"bearer_subaccount,omitempty"`
+}
+
+// Validate checks the shares of a split before it is sent: each share must
+// be positive, and percentage shares must not sum over 100.
+func (r *SplitRequest) Validate() error {
+	if r == nil {
+		return errNilRequest
+	}
+	if r.Type != SplitPercentage && r.Type != SplitFlat {
+		return errors.New("paystack: split type must be SplitPercentage or SplitFlat")
+	}
+	if len(r.Subaccounts) == 0 {
+		return errors.New("paystack: split must have at least one subaccount")
+	}
+	total := 0
+	for _, sa := range r.Subaccounts {
+		if sa.GetSubaccount() == "" {
+			return errors.New("paystack: split share must have a subaccount code")
+		}
+		if sa.GetShare() <= 0 {
+			return fmt.Errorf("paystack: share of subaccount %s must be positive", sa.GetSubaccount())
+		}
+		total += sa.GetShare()
+	}
+	if r.Type == SplitPercentage && total > 100 {
+		return fmt.Errorf("paystack: percentage shares sum to %d%%, over 100%%", total)
+	}
+	if r.BearerType == SplitBearerSubaccount && r.GetBearerSubaccount() == "" {
+		return errors.New("paystack: bearer subaccount is required when the subaccount bears the fees")
+	}
+	return nil
+}
+
+type SplitUpdateRequest struct {
+	Name             *string         `json:"name,omitempty"`
+	Active           *bool           `json:"active,omitempty"`
+	BearerType       SplitBearerType `json:"bearer_type,omitempty"`
+	BearerSubaccount *string         `json:"bearer_subaccount,omitempty"`
+}
+
+// SplitOptions specifies the optional parameters to SplitService.List
+type SplitOptions struct {
+	ListOptions
+
+	// Name filters splits by name.
+	Name string `url:"name,omitempty"`
+
+	// Active filters splits by whether they are active.
+	Active *bool `url:"active,omitempty"`
+
+	// From and To limit splits to those created in the range.
+	From time.Time `url:"from,omitempty"`
+	To   time.Time `url:"to,omitempty"`
+}
+
+// Create creates a split, after checking its shares with Validate
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#create-split
+func (s *SplitService) Create(ctx context.Context, sr *SplitRequest) (*Split, *Response, error) {
+	if err := sr.Validate(); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("split")
+	req, err := s.client.NewRequest("POST", u, sr)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Split])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// List returns the splits available on the integration
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#list-search-split
+func (s *SplitService) List(ctx context.Context, opt *SplitOptions) ([]Split, *Response, error) {
+	u := fmt.Sprintf("split")
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	lr := new(Envelope[[]Split])
+	resp, err := s.client.Do(ctx, req, lr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lr.Data, resp, nil
+}
+
+// ListAll iterates over all splits, calling List for each page
+func (s *SplitService) ListAll(ctx context.Context, opt *SplitOptions, iopt *IterOptions) iter.Seq2[Split, error] {
+	var o SplitOptions
+	if opt != nil {
+		o = *opt
+	}
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]Split, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
+}
+
+// Fetch returns the details of a split
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#fetch-split
+func (s *SplitService) Fetch(ctx context.Context, id string) (*Split, *Response, error) {
+	u := fmt.Sprintf("split/" + id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Split])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// Update updates the name, status or fee bearer of a split
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#update-split
+func (s *SplitService) Update(ctx context.Context, id string, sr *SplitUpdateRequest) (*Split, *Response, error) {
+	u := fmt.Sprintf("split/" + id)
+	req, err := s.client.NewRequest("PUT", u, sr)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Split])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// AddSubaccount adds a subaccount to a split, or updates its share if it is
+// already in the split. The split is fetched first, so that a share taking
+// the shares of a percentage split over 100 is rejected before it is sent.
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#add-subaccount-to-split
+func (s *SplitService) AddSubaccount(ctx context.Context, id string, share *SplitShare) (*Split, *Response, error) {
+	if share.GetSubaccount() == "" || share.GetShare() <= 0 {
+		return nil, nil, errors.New("paystack: split share must have a subaccount code and a positive share")
+	}
+	split, resp, err := s.Fetch(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if split.Type == SplitPercentage {
+		total := share.GetShare()
+		for _, sa := range split.Subaccounts {
+			if sa.Subaccount.GetSubaccountCode() != share.GetSubaccount() {
+				total += sa.GetShare()
+			}
+		}
+		if total > 100 {
+			return nil, resp, fmt.Errorf("paystack: percentage shares would sum to %d%%, over 100%%", total)
+		}
+	}
+	u := fmt.Sprintf("split/" + id + "/subaccount/add")
+	req, err := s.client.NewRequest("POST", u, share)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Split])
+	resp, err = s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// RemoveSubaccount removes a subaccount, given its code, from a split
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#remove-subaccount-from-split
+func (s *SplitService) RemoveSubaccount(ctx context.Context, id string, subaccount string) (*Response, error) {
+	u := fmt.Sprintf("split/" + id + "/subaccount/remove")
+	req, err := s.client.NewRequest("POST", u, &SplitShare{Subaccount: &subaccount})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,202 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSplitService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/split", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		want := map[string]interface{}{
+			"name":     "Percentage Split",
+			"type":     "percentage",
+			"currency": "NGN",
+			"subaccounts": []interface{}{
+				map[string]interface{}{"subaccount": "ACCT_z3x6z3nbo14xsil", "share": float64(20)},
+				map[string]interface{}{"subaccount": "ACCT_pwwualwty4nhq9d", "share": float64(30)},
+			},
+			"bearer_type": "all-proportional",
+		}
+		if !cmp.Equal(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Split created",
+		  "data": {
+			"id": 142,
+			"name": "Percentage Split",
+			"type": "percentage",
+			"currency": "NGN",
+			"integration": 428626,
+			"domain": "test",
+			"split_code": "SPL_e7jnRLtzla",
+			"active": true,
+			"bearer_type": "all-proportional",
+			"bearer_subaccount": null,
+			"subaccounts": [
+			  {"subaccount": {"id": 37614, "subaccount_code": "ACCT_z3x6z3nbo14xsil", "business_name": "Business Name"}, "share": 20},
+			  {"subaccount": {"id": 37615, "subaccount_code": "ACCT_pwwualwty4nhq9d", "business_name": "Business Name"}, "share": 30}
+			],
+			"total_subaccounts": 2
+		  }
+		}`)
+	})
+
+	split, _, err := client.Split.Create(context.Background(), &SplitRequest{
+		Name:     String("Percentage Split"),
+		Type:     SplitPercentage,
+		Currency: NGN.Ptr(),
+		Subaccounts: []SplitShare{
+			{Subaccount: String("ACCT_z3x6z3nbo14xsil"), Share: Int(20)},
+			{Subaccount: String("ACCT_pwwualwty4nhq9d"), Share: Int(30)},
+		},
+		BearerType: SplitBearerAllProportional,
+	})
+	if err != nil {
+		t.Errorf("Split.Create returned error: %v", err)
+	}
+
+	want := &Split{
+		Id:          Int(142),
+		Name:        String("Percentage Split"),
+		Type:        SplitPercentage,
+		Currency:    NGN.Ptr(),
+		Integration: Int(428626),
+		Domain:      String("test"),
+		SplitCode:   String("SPL_e7jnRLtzla"),
+		Active:      Bool(true),
+		BearerType:  SplitBearerAllProportional,
+		Subaccounts: []SplitSubaccount{
+			{Subaccount: Subaccount{Id: Int(37614), SubaccountCode: String("ACCT_z3x6z3nbo14xsil"), BusinessName: String("Business Name")}, Share: Int(20)},
+			{Subaccount: Subaccount{Id: Int(37615), SubaccountCode: String("ACCT_pwwualwty4nhq9d"), BusinessName: String("Business Name")}, Share: Int(30)},
+		},
+		TotalSubaccounts: Int(2),
+	}
+	if !cmp.Equal(split, want) {
+		t.Errorf("Split.Create returned %+v, want %+v", split, want)
+	}
+}
+
+func TestSplitRequest_Validate(t *testing.T) {
+	share := func(code string, v int) SplitShare { return SplitShare{Subaccount: String(code), Share: Int(v)} }
+	tests := []struct {
+		name    string
+		req     SplitRequest
+		wantErr bool
+	}{
+		{"percentage under 100", SplitRequest{Type: SplitPercentage, Subaccounts: []SplitShare{share("A", 40), share("B", 60)}}, false},
+		{"percentage over 100", SplitRequest{Type: SplitPercentage, Subaccounts: []SplitShare{share("A", 60), share("B", 50)}}, true},
+		{"flat over 100", SplitRequest{Type: SplitFlat, Subaccounts: []SplitShare{share("A", 60000), share("B", 50000)}}, false},
+		{"negative share", SplitRequest{Type: SplitFlat, Subaccounts: []SplitShare{share("A", -1)}}, true},
+		{"missing type", SplitRequest{Subaccounts: []SplitShare{share("A", 10)}}, true},
+		{"no subaccounts", SplitRequest{Type: SplitPercentage}, true},
+		{"missing bearer subaccount", SplitRequest{Type: SplitFlat, Subaccounts: []SplitShare{share("A", 10)}, BearerType: SplitBearerSubaccount}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.req.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate returned %v, want error %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestSplitService_Create_invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/split", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Split.Create sent an invalid split")
+	})
+
+	_, _, err := client.Split.Create(context.Background(), &SplitRequest{
+		Type:        SplitPercentage,
+		Subaccounts: []SplitShare{{Subaccount: String("A"), Share: Int(70)}, {Subaccount: String("B"), Share: Int(40)}},
+	})
+	if err == nil {
+		t.Error("Split.Create returned no error for shares over 100%")
+	}
+}
+
+func TestSplitService_AddSubaccount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/split/143", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"status": true, "message": "Split retrieved", "data": {"id": 143, "type": "flat"}}`)
+	})
+	mux.HandleFunc("/split/143/subaccount/add", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if want := map[string]interface{}{"subaccount": "ACCT_hdl8abxl8drhrl3", "share": float64(15)}; !cmp.Equal(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Subaccount added",
+		  "data": {"id": 143, "split_code": "SPL_RcScyW5jp2", "type": "flat", "total_subaccounts": 3}
+		}`)
+	})
+
+	split, _, err := client.Split.AddSubaccount(context.Background(), "143", &SplitShare{Subaccount: String("ACCT_hdl8abxl8drhrl3"), Share: Int(15)})
+	if err != nil {
+		t.Errorf("Split.AddSubaccount returned error: %v", err)
+	}
+
+	want := &Split{Id: Int(143), SplitCode: String("SPL_RcScyW5jp2"), Type: SplitFlat, TotalSubaccounts: Int(3)}
+	if !cmp.Equal(split, want) {
+		t.Errorf("Split.AddSubaccount returned %+v, want %+v", split, want)
+	}
+}
+
+func TestSplitService_AddSubaccount_over100(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/split/143", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Split retrieved",
+		  "data": {
+		    "id": 143,
+		    "type": "percentage",
+		    "subaccounts": [
+		      {"subaccount": {"subaccount_code": "ACCT_eg4sob4590pq9vb"}, "share": 60},
+		      {"subaccount": {"subaccount_code": "ACCT_hdl8abxl8drhrl3"}, "share": 10}
+		    ]
+		  }
+		}`)
+	})
+	added := false
+	mux.HandleFunc("/split/143/subaccount/add", func(w http.ResponseWriter, r *http.Request) {
+		added = true
+		fmt.Fprint(w, `{"status": true, "message": "Subaccount added", "data": {"id": 143}}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Split.AddSubaccount(ctx, "143", &SplitShare{Subaccount: String("ACCT_new"), Share: Int(41)}); err == nil || added {
+		t.Errorf("Split.AddSubaccount taking the shares to 111%% returned %v and sent the share: %v", err, added)
+	}
+	// Updating a share replaces it in the total.
+	if _, _, err := client.Split.AddSubaccount(ctx, "143", &SplitShare{Subaccount: String("ACCT_hdl8abxl8drhrl3"), Share: Int(40)}); err != nil || !added {
+		t.Errorf("Split.AddSubaccount taking the shares to 100%% returned %v and sent the share: %v", err, added)
+	}
+}
+
+func TestSplitService_Create_nil(t *testing.T) {
+	if _, _, err := NewClient(nil).Split.Create(context.Background(), nil); err == nil {
+		t.Error("Split.Create with a nil request returned no error")
+	}
+}
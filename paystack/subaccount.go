@@ -3,6 +3,7 @@ package paystack
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"time"
 )
@@ -32,6 +33,15 @@ type Subaccount struct {
 	UpdatedAt           *time.Time `json:"updated_at, omitempty"`
 }
 
+// UnmarshalJSON also accepts the subaccount id or code, which some endpoints
+// return in place of the subaccount object.
+func (s *Subaccount) UnmarshalJSON(data []byte) error {
+	type subaccount Subaccount
+	return unmarshalRef(data, (*subaccount)(s),
+		func(id int) { s.Id = &id },
+		func(code string) { s.SubaccountCode = &code })
+}
+
 type SubaccountRequest struct {
 	BusinessName        *string  `json:"business_name, omitempty"`
 	PrimaryContactName  *string  `json:"primary_contact_name, omitempty"`
@@ -54,15 +64,12 @@ func (s *SubaccountService) Create(ctx context.Context, sr *SubaccountRequest) (
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Subaccount])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-
-	var sa Subaccount
-	mapDecoder(r.Data, sa)
-	return &sa, resp, nil
+	return &r.Data, resp, nil
 }
 
 // List returns an array of all created subaccounts
@@ -81,20 +88,25 @@ func (s *SubaccountService) List(ctx context.Context, opt *ListOptions) ([]Subac
 		return nil, nil, err
 	}
 
-	r := new(StandardListResponse)
+	r := new(Envelope[[]Subaccount])
 	resp, err := s.client.Do(ctx, req, r)
-
 	if err != nil {
 		return nil, resp, err
 	}
+	return r.Data, resp, nil
+}
 
-	var saa []Subaccount
-	sa := new(Subaccount)
-	for _, x := range r.Data {
-		mapDecoder(x, sa)
-		saa = append(saa, *sa)
+// ListAll iterates over all subaccounts, calling List for each page
+func (s *SubaccountService) ListAll(ctx context.Context, opt *ListOptions, iopt *IterOptions) iter.Seq2[Subaccount, error] {
+	var o ListOptions
+	if opt != nil {
+		o = *opt
 	}
-	return saa, resp, nil
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]Subaccount, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
 }
 
 // Fetch returns a subaccount with the passed id
@@ -107,14 +119,12 @@ func (s *SubaccountService) Fetch(ctx context.Context, id string) (*Subaccount,
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Subaccount])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	var sa Subaccount
-	mapDecoder(r.Data, sa)
-	return &sa, resp, nil
+	return &r.Data, resp, nil
 }
 
 // Update updates a subaccount model with the subaccount
@@ -127,12 +137,10 @@ func (s *SubaccountService) Update(ctx context.Context, sa *SubaccountRequest, i
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Subaccount])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	var sar Subaccount
-	mapDecoder(r.Data, sar)
-	return &sar, resp, nil
+	return &r.Data, resp, nil
 }
@@ -3,6 +3,7 @@ package paystack
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"time"
 )
@@ -19,7 +20,7 @@ type Subscription struct {
 	Start            *int64        `json:"start, omitempty"`
 	Status           *string       `json:"status, omitempty"`
 	Quantity         *int          `json:"quantity, omitempty"`
-	Amount           *int          `json:"amount, omitempty"`
+	Amount           *Money        `json:"amount, omitempty"`
 	SubscriptionCode *string       `json:"subscription_code, omitempty"`
 	EmailToken       *string       `json:"email_token, omitempty"`
 	EasyCronId       *int          `json:"easy_cron_id, omitempty"`
@@ -39,7 +40,7 @@ type SubscriptionResponse struct {
 	Start            *int64     `json:"start, omitempty"`
 	Status           *string    `json:"status, omitempty"`
 	Quantity         *int       `json:"quantity, omitempty"`
-	Amount           *int       `json:"amount, omitempty"`
+	Amount           *Money     `json:"amount, omitempty"`
 	Authorization    *int       `json:"authorization, omitempty"`
 	SubscriptionCode *string    `json:"subscription_code, omitempty"`
 	EmailToken       *string    `json:"email_token, omitempty"`
@@ -67,15 +68,12 @@ func (s *SubscriptionService) Create(ctx context.Context, sa *Subscription) (*Su
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[SubscriptionResponse])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-
-	var c SubscriptionResponse
-	mapDecoder(r.Data, c)
-	return &c, resp, nil
+	return &r.Data, resp, nil
 }
 
 // List lists all created subscriptions
@@ -94,20 +92,25 @@ func (s *SubscriptionService) List(ctx context.Context, opt *SubscriptionOptions
 		return nil, nil, err
 	}
 
-	lr := new(StandardListResponse)
+	lr := new(Envelope[[]Subscription])
 	resp, err := s.client.Do(ctx, req, lr)
-
 	if err != nil {
 		return nil, resp, err
 	}
+	return lr.Data, resp, nil
+}
 
-	var sa []Subscription
-	st := new(Subscription)
-	for _, x := range lr.Data {
-		mapDecoder(x, st)
-		sa = append(sa, *st)
+// ListAll iterates over all subscriptions, calling List for each page
+func (s *SubscriptionService) ListAll(ctx context.Context, opt *SubscriptionOptions, iopt *IterOptions) iter.Seq2[Subscription, error] {
+	var o SubscriptionOptions
+	if opt != nil {
+		o = *opt
 	}
-	return sa, resp, nil
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]Subscription, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
 }
 
 // Fetch fetches a subscription
@@ -120,17 +123,12 @@ func (s *SubscriptionService) Fetch(ctx context.Context, id string) (*Subscripti
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Subscription])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	var st Subscription
-	err = mapDecoder(r.Data, &st)
-	if err != nil {
-		return nil, resp, err
-	}
-	return &st, resp, nil
+	return &r.Data, resp, nil
 }
 
 // Disable disables a subscription model with the supplied parameters
@@ -148,14 +146,12 @@ func (s *SubscriptionService) Disable(ctx context.Context, sa *SubscriptionReque
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Message])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(Message)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
 
 // Enable enables a subscription model with the supplied parameters
@@ -173,12 +169,10 @@ func (s *SubscriptionService) Enable(ctx context.Context, sa *SubscriptionReques
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Message])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(Message)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
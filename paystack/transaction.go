@@ -5,6 +5,7 @@ package paystack
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 )
 
@@ -12,66 +13,86 @@ import (
 type TransactionService service
 
 type TransactionRequest struct {
-	CallbackUrl       *string  `json:"callback_url, omitempty"`
-	Reference         *string  `json:"reference, omitempty"`
-	AuthorizationCode *string  `json:"authorization_code, omitempty"`
-	Amount            *string  `json:"amount, omitempty"`
-	Currency          *string  `json:"currency"`
-	Email             *string  `json:"email, omitempty"`
-	Plan              *string  `json:"plan, omitempty"`
-	InvoiceLimit      *int32   `json:"invoice_limit, omitempty"`
-	Metadata          Metadata `json:"metadata, omitempty"`
-	Subaccount        *string  `json:"subaccount, omitempty"`
-	TransactionCharge *int32   `json:"transaction_charge, omitempty"`
-	Bearer            *string  `json:"bearer, omitempty"`
-	Channels          []string `json:"channels, omitempty"`
+	CallbackUrl       *string   `json:"callback_url, omitempty"`
+	Reference         *string   `json:"reference, omitempty"`
+	AuthorizationCode *string   `json:"authorization_code, omitempty"`
+	Amount            *Money    `json:"amount, omitempty"`
+	Currency          *Currency `json:"currency"`
+	Email             *string   `json:"email, omitempty"`
+	Plan              *string   `json:"plan, omitempty"`
+	InvoiceLimit      *int32    `json:"invoice_limit, omitempty"`
+	Metadata          Metadata  `json:"metadata, omitempty"`
+	Subaccount        *string   `json:"subaccount, omitempty"`
+	TransactionCharge *Money    `json:"transaction_charge, omitempty"`
+	Bearer            *string   `json:"bearer, omitempty"`
+	Channels          []string  `json:"channels, omitempty"`
+	SplitCode         *string   `json:"split_code,omitempty"`
 }
 
 type Transaction struct {
-	Amount          *int          `json:"amount, omitempty"`
-	Currency        *string       `json:"currency, omitempty"`
+	Amount          *Money        `json:"amount, omitempty"`
+	Currency        *Currency     `json:"currency, omitempty"`
 	TransactionDate *time.Time    `json:"transaction_date, omitempty"`
 	Status          *string       `json:"status, omitempty"`
 	Reference       *string       `json:"reference, omitempty"`
 	Domain          *string       `json:"domain, omitempty"`
-	Metadata        map[string]interface{}       `json:"metadata, omitempty"`	//Paystack API is not consistent with return type
+	Metadata        MetadataMap   `json:"metadata, omitempty"` //Paystack API is not consistent with return type
 	GatewayResponse *string       `json:"gateway_response, omitempty"`
 	Message         *string       `json:"message, omitempty"`
 	Channel         *string       `json:"channel, omitempty"`
 	IpAddress       *string       `json:"ip_address, omitempty"`
 	Log             Log           `json:"log, omitempty"`
-	Fees            *int          `json:"fees, omitempty"`
+	Fees            *Money        `json:"fees, omitempty"`
 	Authorization   Authorization `json:"authorization, omitempty"`
 	Customer        Customer      `json:"customer, omitempty"`
 	Plan            Plan          `json:"plan, omitempty"`
 	Id              *int          `json:"id, omitempty"`
 	PaidAt          *time.Time    `json:"paid_at, omitempty"`
 	CreatedAt       *time.Time    `json:"created_at, omitempty"`
-	FeesSplit       *int          `json:"fees_split, omitempty"`
+	FeesSplit       *Money        `json:"fees_split, omitempty"`
 	Subaccount      Subaccount    `json:"subaccount, omitempty"`
+
+	// Set by charges waiting on the customer, see NextAction.
+	DisplayText      *string    `json:"display_text,omitempty"`
+	URL              *string    `json:"url,omitempty"`
+	USSDCode         *string    `json:"ussd_code,omitempty"`
+	QRCode           *string    `json:"qr_code,omitempty"`
+	AccountName      *string    `json:"account_name,omitempty"`
+	AccountNumber    *string    `json:"account_number,omitempty"`
+	Bank             *Bank      `json:"bank,omitempty"`
+	AccountExpiresAt *time.Time `json:"account_expires_at,omitempty"`
+}
+
+// UnmarshalJSON also accepts the transaction id or reference, which some
+// endpoints return in place of the transaction object.
+func (t *Transaction) UnmarshalJSON(data []byte) error {
+	type transaction Transaction
+	return unmarshalRef(data, (*transaction)(t),
+		func(id int) { t.Id = &id },
+		func(reference string) { t.Reference = &reference })
 }
 
 type TransactionVerify struct {
-	Amount          *int          `json:"amount, omitempty"`
-	Currency        *string       `json:"currency, omitempty"`
+	Amount          *Money        `json:"amount, omitempty"`
+	Currency        *Currency     `json:"currency, omitempty"`
 	TransactionDate *time.Time    `json:"transaction_date, omitempty"`
 	Status          *string       `json:"status, omitempty"`
 	Reference       *string       `json:"reference, omitempty"`
 	Domain          *string       `json:"domain, omitempty"`
-	Metadata        map[string]interface{}       `json:"metadata, omitempty"`	//Paystack API is not consistent with return type
+	Metadata        MetadataMap   `json:"metadata, omitempty"` //Paystack API is not consistent with return type
 	GatewayResponse *string       `json:"gateway_response, omitempty"`
 	Message         *string       `json:"message, omitempty"`
 	Channel         *string       `json:"channel, omitempty"`
 	IpAddress       *string       `json:"ip_address, omitempty"`
 	Log             Log           `json:"log, omitempty"`
-	Fees            *int          `json:"fees, omitempty"`
+	Fees            *Money        `json:"fees, omitempty"`
 	Authorization   Authorization `json:"authorization, omitempty"`
 	Customer        Customer      `json:"customer, omitempty"`
-	Plan            *string        `json:"plan, omitempty"`
+	Plan            *string       `json:"plan, omitempty"`
 	Id              *int          `json:"id, omitempty"`
 	PaidAt          *time.Time    `json:"paid_at, omitempty"`
 	CreatedAt       *time.Time    `json:"created_at, omitempty"`
-	FeesSplit       *int          `json:"fees_split, omitempty"`
+	FeesSplit       *Money        `json:"fees_split, omitempty"`
 	Subaccount      Subaccount    `json:"subaccount, omitempty"`
 }
 
@@ -95,10 +116,13 @@ type TransactionTimeline struct {
 }
 
 type TransactionTotal struct {
-	TotalTransactions     int               `json:"total_transactions, omitempty"`
-	UniqueCustomers       int               `json:"unique_customers, omitempty"`
-	TotalVolume           int64             `json:"total_volume, omitempty"`
-	TotalVolumeByCurrency []FieldByCurrency `json:"total_volume_by_currency, omitempty"`
+	TotalTransactions int `json:"total_transactions, omitempty"`
+	UniqueCustomers   int `json:"unique_customers, omitempty"`
+
+	// TotalVolume adds up the amounts of every currency, which
+	// TotalVolumeByCurrency gives apart.
+	TotalVolume           int64   `json:"total_volume, omitempty"`
+	TotalVolumeByCurrency []Money `json:"total_volume_by_currency, omitempty"`
 }
 
 type ExportRequest struct {
@@ -107,9 +131,9 @@ type ExportRequest struct {
 	Settled     *bool      `json:"settled, omitempty"`
 	PaymentPage *int32     `json:"payment_page, omitempty"`
 	Customer    *int32     `json:"customer, omitempty"`
-	Currency    *string    `json:"currency, omitempty"`
+	Currency    *Currency  `json:"currency, omitempty"`
 	Settlement  *string    `json:"settlement, omitempty"`
-	Amount      *int32     `json:"amount, omitempty"`
+	Amount      *Money     `json:"amount, omitempty"`
 	Status      *string    `json:"status, omitempty"`
 }
 
@@ -124,23 +148,29 @@ type Reauthorization struct {
 
 // Initialize
 //
+// There is no InitializeOnce: initializing a transaction charges nobody, and
+// verifying it cannot return the access code and authorization URL that the
+// caller needs. After a failure whose outcome is unknown, submit the same
+// request again: it fails with ErrDuplicateReference if the first attempt
+// was processed, in which case initialize the payment with a new reference.
+//
 // Paystack API reference:
 // https://developers.paystack.co/reference#initialize-a-transaction
 func (s *TransactionService) Initialize(ctx context.Context, tr *TransactionRequest) (*TransactionAuthorization, *Response, error) {
 	u := fmt.Sprintf("transaction/initialize")
+	if tr != nil {
+		s.client.setReference(&tr.Reference)
+	}
 	req, err := s.client.NewRequest("POST", u, tr)
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[TransactionAuthorization])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-
-	var t TransactionAuthorization
-	mapDecoder(r.Data, &t)
-	return &t, resp, nil
+	return &r.Data, resp, nil
 }
 
 //Verify creates a new customer
@@ -153,15 +183,12 @@ func (s *TransactionService) Verify(ctx context.Context, reference string) (*Tra
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[TransactionVerify])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-
-	var t TransactionVerify
-	mapDecoder(r.Data, &t)
-	return &t, resp, nil
+	return &r.Data, resp, nil
 }
 
 // List lists all transactions
@@ -177,20 +204,25 @@ func (s *TransactionService) List(ctx context.Context, opt *TransactionOptions)
 		return nil, nil, err
 	}
 
-	r := new(StandardListResponse)
+	r := new(Envelope[[]Transaction])
 	resp, err := s.client.Do(ctx, req, r)
-
 	if err != nil {
 		return nil, resp, err
 	}
+	return r.Data, resp, nil
+}
 
-	var ta []Transaction
-	t := new(Transaction)
-	for _, x := range r.Data {
-		mapDecoder(x, t)
-		ta = append(ta, *t)
+// ListAll iterates over all transactions, calling List for each page
+func (s *TransactionService) ListAll(ctx context.Context, opt *TransactionOptions, iopt *IterOptions) iter.Seq2[Transaction, error] {
+	var o TransactionOptions
+	if opt != nil {
+		o = *opt
 	}
-	return ta, resp, nil
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]Transaction, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
 }
 
 // Fetch fetches a transaction
@@ -203,14 +235,12 @@ func (s *TransactionService) Fetch(ctx context.Context, id string) (*Transaction
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Transaction])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	var t Transaction
-	mapDecoder(r.Data, t)
-	return &t, resp, nil
+	return &r.Data, resp, nil
 }
 
 //ChargeAuthorization
@@ -220,18 +250,53 @@ func (s *TransactionService) Fetch(ctx context.Context, id string) (*Transaction
 func (s *TransactionService) ChargeAuthorization(ctx context.Context, tr *TransactionRequest) (*Transaction, *Response, error) {
 
 	u := fmt.Sprintf("transaction/charge_authorization")
+	if tr != nil {
+		s.client.setReference(&tr.Reference)
+	}
 	req, err := s.client.NewRequest("POST", u, tr)
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Transaction])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
+}
+
+// ChargeAuthorizationOnce charges an authorization like ChargeAuthorization,
+// but when the outcome of an attempt is unknown it verifies the transaction
+// by reference before charging again, so that the customer is charged at
+// most once. A reference is generated for tr if it has none.
+//
+// If the outcome cannot be established, the error matches
+// ErrOutcomeUnknown and calling ChargeAuthorizationOnce again with tr is
+// safe.
+func (s *TransactionService) ChargeAuthorizationOnce(ctx context.Context, tr *TransactionRequest) (*Transaction, *Response, error) {
+	if tr == nil {
+		return nil, nil, errNilRequest
+	}
+	s.client.ensureReference(&tr.Reference)
+	return submitOnce(ctx, s.client, *tr.Reference,
+		func(ctx context.Context) (*Transaction, *Response, error) { return s.ChargeAuthorization(ctx, tr) },
+		s.verifyTransaction)
+}
+
+// verifyTransaction is Verify returning a Transaction, which the response
+// decodes into as well.
+func (s *TransactionService) verifyTransaction(ctx context.Context, reference string) (*Transaction, *Response, error) {
+	u := fmt.Sprintf("transaction/verify/" + reference)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Transaction])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	var t Transaction
-	mapDecoder(r.Data, t)
-	return &t, resp, nil
+	return &r.Data, resp, nil
 }
 
 // Timeline fetches a transaction timeline
@@ -244,14 +309,12 @@ func (s *TransactionService) Timeline(ctx context.Context, id string) (*Transact
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[TransactionTimeline])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	var tt TransactionTimeline
-	mapDecoder(r.Data, tt)
-	return &tt, resp, nil
+	return &r.Data, resp, nil
 }
 
 // Totals fetches a transaction timeline
@@ -270,14 +333,12 @@ func (s *TransactionService) Totals(ctx context.Context, opt *TransactionOptions
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[TransactionTotal])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	var t TransactionTotal
-	mapDecoder(r.Data, t)
-	return &t, resp, nil
+	return &r.Data, resp, nil
 }
 
 // Export a transaction
@@ -287,18 +348,19 @@ func (s *TransactionService) Totals(ctx context.Context, opt *TransactionOptions
 func (s *TransactionService) Export(ctx context.Context, opt *TransactionOptions) (*ExportPath, *Response, error) {
 	u := fmt.Sprintf("transaction/export")
 	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[ExportPath])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	var ep ExportPath
-	mapDecoder(r.Data, ep)
-	return &ep, resp, nil
+	return &r.Data, resp, nil
 }
 
 // RequestReauthorization
@@ -317,21 +379,19 @@ func (s *TransactionService) RequestReauthorization(ctx context.Context, opt *Tr
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Reauthorization])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	var ep Reauthorization
-	mapDecoder(r.Data, ep)
-	return &ep, resp, nil
+	return &r.Data, resp, nil
 }
 
 // CheckAuthorization
 //
 // Paystack API reference:
 // https://developers.paystack.co/reference#check-authorization
-func (s *TransactionService) CheckAuthorization(ctx context.Context, opt *TransactionRequest) (*FieldByCurrency, *Response, error) {
+func (s *TransactionService) CheckAuthorization(ctx context.Context, opt *TransactionRequest) (*Money, *Response, error) {
 	u := fmt.Sprintf("transaction/check_authorization ")
 
 	u, err := addOptions(u, opt)
@@ -343,13 +403,10 @@ func (s *TransactionService) CheckAuthorization(ctx context.Context, opt *Transa
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Money])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-
-	var fbc FieldByCurrency
-	mapDecoder(r.Data, fbc)
-	return &fbc, resp, nil
+	return &r.Data, resp, nil
 }
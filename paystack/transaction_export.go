@@ -0,0 +1,275 @@
+package paystack
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// defaultExportWindow is the longest date range requested in one export by
+// ExportAndDownload, unless set by ExportOptions.
+const defaultExportWindow = 30 * 24 * time.Hour
+
+// exportTimeLayouts are the layouts of the dates found in exports.
+var exportTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// ExportRow is a transaction read from an export.
+type ExportRow struct {
+	Reference     string
+	Amount        Money
+	Status        string
+	Channel       string
+	Fees          Money
+	CustomerEmail string
+
+	// PaidAt is the zero time when the transaction was not paid.
+	PaidAt time.Time
+
+	// Fields holds every column of the row by header, including the ones
+	// above.
+	Fields map[string]string
+}
+
+// ExportOptions configures ExportAndDownload.
+type ExportOptions struct {
+	// Window is the longest date range exported at once, 30 days by
+	// default. Longer ranges are exported window by window.
+	Window time.Duration
+}
+
+// ExportAndDownload exports the transactions matching opt, downloads the
+// exports and returns an iterator over their rows, read as they are
+// downloaded. opt and eopt may be nil.
+//
+// A date range longer than eopt.Window is split into consecutive windows,
+// exported one after the other, and their rows are yielded in order. Each
+// window starts where the previous one ends, and since Paystack includes
+// both ends of a range, and may round them to whole days, a transaction at
+// a boundary can be in two exports: rows whose reference was in the
+// previous window are skipped, so only the references of one window are
+// kept in memory. When opt.From is set and opt.To is not, the range ends
+// now. Errors are yielded once, with a nil row, and end the iteration.
+//
+// The exports are downloaded with the http.Client of the client, but
+// without its middlewares or secret key, since their URLs are signed.
+func (s *TransactionService) ExportAndDownload(ctx context.Context, opt *TransactionOptions, eopt *ExportOptions) iter.Seq2[*ExportRow, error] {
+	var o TransactionOptions
+	if opt != nil {
+		o = *opt
+	}
+	window := defaultExportWindow
+	if eopt != nil && eopt.Window > 0 {
+		window = eopt.Window
+	}
+	return func(yield func(*ExportRow, error) bool) {
+		var prev map[string]bool
+		for w := range exportWindows(o, window) {
+			seen := make(map[string]bool)
+			err := s.download(ctx, &w, func(row *ExportRow) bool {
+				if row.Reference != "" {
+					if prev[row.Reference] {
+						return true
+					}
+					seen[row.Reference] = true
+				}
+				return yield(row, nil)
+			})
+			prev = seen
+			if errors.Is(err, errStopped) {
+				return
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+		}
+	}
+}
+
+// exportWindows splits the date range of opt into consecutive windows of at
+// most window, each starting at the end of the previous one so that no
+// instant of the range is left out.
+func exportWindows(opt TransactionOptions, window time.Duration) iter.Seq[TransactionOptions] {
+	return func(yield func(TransactionOptions) bool) {
+		if opt.From.IsZero() {
+			yield(opt)
+			return
+		}
+		to := opt.To
+		if to.IsZero() {
+			to = time.Now()
+		}
+		for from := opt.From; ; {
+			end := from.Add(window)
+			last := !end.Before(to)
+			if last {
+				end = to
+			}
+			w := opt
+			w.From, w.To = from, end
+			if !yield(w) || last {
+				return
+			}
+			from = end
+		}
+	}
+}
+
+// errStopped is returned by download when the caller stopped reading rows.
+var errStopped = errors.New("paystack: stopped")
+
+// download exports the transactions matching opt and reads the export,
+// passing each row to f until it returns false.
+func (s *TransactionService) download(ctx context.Context, opt *TransactionOptions, f func(*ExportRow) bool) error {
+	path, _, err := s.Export(ctx, opt)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", path.Path, nil)
+	if err != nil {
+		return err
+	}
+	if s.client.UserAgent != "" {
+		req.Header.Set("User-Agent", s.client.UserAgent)
+	}
+	resp, err := s.client.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("paystack: downloading export: %s", resp.Status)
+	}
+
+	currency := NGN
+	if opt.Currency != nil {
+		currency = *opt.Currency
+	}
+	r := csv.NewReader(resp.Body)
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("paystack: reading export: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		h = strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))
+		header[i] = h
+		columns[exportColumn(h)] = i
+	}
+	for _, c := range []string{"reference", "amount"} {
+		if _, ok := columns[c]; !ok {
+			return fmt.Errorf("paystack: export has no %s column", c)
+		}
+	}
+
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("paystack: reading export: %w", err)
+		}
+		line, _ := r.FieldPos(0)
+		row, err := parseExportRow(header, columns, record, currency)
+		if err != nil {
+			return fmt.Errorf("paystack: export line %d: %w", line, err)
+		}
+		if !f(row) {
+			return errStopped
+		}
+	}
+}
+
+// exportColumn normalizes the header of an export column, so that
+// "Customer Email", "customer_email" and "CustomerEmail" are the same.
+func exportColumn(header string) string {
+	c := strings.ToLower(header)
+	c = strings.NewReplacer(" ", "", "_", "", "-", "").Replace(c)
+	switch c {
+	case "email":
+		return "customeremail"
+	case "paidon", "datepaid":
+		return "paidat"
+	case "transactionreference":
+		return "reference"
+	}
+	return c
+}
+
+// parseExportRow reads record, whose columns are named by header and found
+// by columns, amounts being in currency unless the row has its own.
+func parseExportRow(header []string, columns map[string]int, record []string, currency Currency) (*ExportRow, error) {
+	get := func(c string) string {
+		if i, ok := columns[c]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	row := &ExportRow{
+		Reference:     get("reference"),
+		Status:        get("status"),
+		Channel:       get("channel"),
+		CustomerEmail: get("customeremail"),
+		Fields:        make(map[string]string, len(header)),
+	}
+	for i, h := range header {
+		if i < len(record) {
+			row.Fields[h] = record[i]
+		}
+	}
+	if c := get("currency"); c != "" {
+		currency = Currency(strings.ToUpper(c))
+	}
+	amounts := []struct {
+		column string
+		m      *Money
+	}{
+		{"amount", &row.Amount},
+		{"fees", &row.Fees},
+	}
+	for _, a := range amounts {
+		a.m.Currency = currency
+		v := strings.ReplaceAll(get(a.column), ",", "")
+		if v == "" {
+			continue
+		}
+		m, err := ParseMoney(v, currency)
+		if err != nil {
+			return nil, err
+		}
+		*a.m = *m
+	}
+	if v := get("paidat"); v != "" {
+		t, err := parseExportTime(v)
+		if err != nil {
+			return nil, err
+		}
+		row.PaidAt = t
+	}
+	return row, nil
+}
+
+func parseExportTime(s string) (time.Time, error) {
+	for _, layout := range exportTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("paystack: invalid date %q", s)
+}
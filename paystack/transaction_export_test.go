@@ -0,0 +1,114 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTransactionService_ExportAndDownload(t *testing.T) {
+	setup()
+	defer teardown()
+
+	files := map[string]string{
+		"2024-01-01T00:00:00Z": "\ufeffReference,Amount,Currency,Status,Channel,Fees,Customer Email,Paid At\n" +
+			"T1,\"1,500.50\",NGN,success,card,22.51,bojack@horsinaround.com,2024-01-05 10:00:00\n" +
+			"T2,200,NGN,abandoned,,0,todd@horsinaround.com,\n",
+		"2024-01-11T00:00:00Z": "reference,amount,status,channel,fees,email,paid_at\n" +
+			"T2,200,abandoned,,0,todd@horsinaround.com,\n" +
+			"T3,99.99,success,bank,1.5,diane@horsinaround.com,2024-01-12T08:30:00Z\n",
+		"2024-01-21T00:00:00Z": "reference,amount,status\n" +
+			"T3,99.99,success\n",
+	}
+	var windows []string
+	mux.HandleFunc("/transaction/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		from, to := r.FormValue("from"), r.FormValue("to")
+		windows = append(windows, from+" "+to)
+		if r.FormValue("status") != "success" {
+			t.Errorf("Export status = %q, want success", r.FormValue("status"))
+		}
+		fmt.Fprintf(w, `{"status": true, "message": "Export successful", "data": {"path": "%s/files/%s.csv"}}`, server.URL, from)
+	})
+	mux.HandleFunc("/files/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("Export download sent Authorization header %q", r.Header.Get("Authorization"))
+		}
+		fmt.Fprint(w, files[strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/files/"), ".csv")])
+	})
+
+	opt := &TransactionOptions{
+		Status: "success",
+		From:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:     time.Date(2024, 1, 25, 0, 0, 0, 0, time.UTC),
+	}
+	var rows []*ExportRow
+	for row, err := range client.Transaction.ExportAndDownload(context.Background(), opt, &ExportOptions{Window: 10 * 24 * time.Hour}) {
+		if err != nil {
+			t.Fatalf("Transaction.ExportAndDownload returned error: %v", err)
+		}
+		rows = append(rows, row)
+	}
+
+	wantWindows := []string{
+		"2024-01-01T00:00:00Z 2024-01-11T00:00:00Z",
+		"2024-01-11T00:00:00Z 2024-01-21T00:00:00Z",
+		"2024-01-21T00:00:00Z 2024-01-25T00:00:00Z",
+	}
+	if !reflect.DeepEqual(windows, wantWindows) {
+		t.Errorf("Transaction.ExportAndDownload exported windows %q, want %q", windows, wantWindows)
+	}
+
+	if len(rows) != 3 {
+		t.Fatalf("Transaction.ExportAndDownload returned %d rows, want 3", len(rows))
+	}
+	want := &ExportRow{
+		Reference:     "T1",
+		Amount:        Money{150050, NGN},
+		Status:        "success",
+		Channel:       "card",
+		Fees:          Money{2251, NGN},
+		CustomerEmail: "bojack@horsinaround.com",
+		PaidAt:        time.Date(2024, 1, 5, 10, 0, 0, 0, time.UTC),
+	}
+	got := *rows[0]
+	got.Fields = nil
+	if !reflect.DeepEqual(&got, want) {
+		t.Errorf("Transaction.ExportAndDownload returned %+v, want %+v", got, want)
+	}
+	if rows[0].Fields["Customer Email"] != "bojack@horsinaround.com" {
+		t.Errorf("Transaction.ExportAndDownload returned fields %v", rows[0].Fields)
+	}
+	if !rows[1].PaidAt.IsZero() || rows[2].Reference != "T3" || rows[2].Amount.Amount != 9999 {
+		t.Errorf("Transaction.ExportAndDownload returned %+v and %+v", rows[1], rows[2])
+	}
+}
+
+func TestTransactionService_ExportAndDownload_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transaction/export", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"status": true, "message": "Export successful", "data": {"path": "%s/files/export.csv"}}`, server.URL)
+	})
+	mux.HandleFunc("/files/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "reference,amount\nT1,100\nT2,abc\nT3,300\n")
+	})
+
+	var refs []string
+	var err error
+	for row, e := range client.Transaction.ExportAndDownload(context.Background(), nil, nil) {
+		if e != nil {
+			err = e
+			continue
+		}
+		refs = append(refs, row.Reference)
+	}
+	if len(refs) != 1 || err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("Transaction.ExportAndDownload returned %v and error %v, want T1 then an error on line 3", refs, err)
+	}
+}
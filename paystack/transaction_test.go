@@ -114,7 +114,7 @@ func TestTransactionService_Verify(t *testing.T) {
 	tranxDate := time.Date(2016, 10, 01, 11, 3, 9, 0, time.UTC)
 
 	//Metadata and Input Omitted in test
-	want := &TransactionVerify{ Amount:Int(27000), Currency:String("NGN"), TransactionDate: &tranxDate, Status:String("success"), Reference:String("DG4uishudoq90LD"), Domain:String("test"), GatewayResponse:String("Successful"), Channel:String("card"), IpAddress:String("41.1.25.1"), Log:Log{TimeSpent:Int(9), Attempts: Int(1), Errors:Int(0), Success:Bool(true), Mobile:Bool(false), History:[]History{
+	want := &TransactionVerify{ Amount:NewMoney(27000, NGN), Currency:NGN.Ptr(), TransactionDate: &tranxDate, Status:String("success"), Reference:String("DG4uishudoq90LD"), Domain:String("test"), GatewayResponse:String("Successful"), Channel:String("card"), IpAddress:String("41.1.25.1"), Log:Log{TimeSpent:Int(9), Attempts: Int(1), Errors:Int(0), Success:Bool(true), Mobile:Bool(false), History:[]History{
 		{Type:String("input"), Message:String("Filled these fields: card number, card expiry, card cvv"), Time:Int(7)},
 		{Type:String("action"), Message:String("Attempted to pay"), Time:Int(7)}}},
 		Authorization:Authorization{AuthorizationCode:String("AUTH_8dfhjjdt"), CardType:String("visa"), Last4:String("1381"), ExpMonth:String("08"), ExpYear:String("2018"), Bin:String("412345"), Bank:String("TEST BANK"), Channel:String("card"), Signature:String("SIG_idyuhgd87dUYSHO92D"), Reusable:Bool(true), CountryCode:String("NG")},
@@ -123,3 +123,68 @@ func TestTransactionService_Verify(t *testing.T) {
 		t.Errorf("Transaction.Verify returned %+v, want %+v", tranx, want)
 	}
 }
+
+func TestTransactionService_Fetch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transaction/288", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Transaction retrieved",
+		  "data": {
+			"id": 288,
+			"domain": "test",
+			"status": "success",
+			"reference": "1e2fa0t9bb",
+			"amount": 10000,
+			"currency": "NGN",
+			"metadata": "",
+			"plan": {},
+			"customer": {"id": 84312, "email": "bojack@horseman.com"}
+		  }
+		}`)
+	})
+
+	tranx, _, err := client.Transaction.Fetch(context.Background(), "288")
+	if err != nil {
+		t.Errorf("Transaction.Fetch returned error: %v", err)
+	}
+
+	want := &Transaction{Id: Int(288), Domain: String("test"), Status: String("success"), Reference: String("1e2fa0t9bb"),
+		Amount: NewMoney(10000, NGN), Currency: NGN.Ptr(), Customer: Customer{Id: Int(84312), Email: String("bojack@horseman.com")}}
+	if !cmp.Equal(tranx, want) {
+		t.Errorf("Transaction.Fetch returned %+v, want %+v", tranx, want)
+	}
+}
+
+func TestTransactionService_Totals(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transaction/totals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Transaction totals",
+		  "data": {
+			"total_transactions": 10,
+			"unique_customers": 3,
+			"total_volume": 14000,
+			"total_volume_by_currency": [{"currency": "NGN", "amount": 14000}]
+		  }
+		}`)
+	})
+
+	totals, _, err := client.Transaction.Totals(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Transaction.Totals returned error: %v", err)
+	}
+
+	want := &TransactionTotal{TotalTransactions: 10, UniqueCustomers: 3, TotalVolume: 14000,
+		TotalVolumeByCurrency: []Money{{Amount: 14000, Currency: NGN}}}
+	if !cmp.Equal(totals, want) {
+		t.Errorf("Transaction.Totals returned %+v, want %+v", totals, want)
+	}
+}
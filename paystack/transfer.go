@@ -3,6 +3,7 @@ package paystack
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 )
 
@@ -13,26 +14,44 @@ type Transfer struct {
 	Integration   *int              `json:"integration, omitempty"`
 	Recipient     TransferRecipient `json:"recipient, omitempty"`
 	Domain        *string           `json:"domain, omitempty"`
-	Amount        *int              `json:"amount, omitempty"`
-	Currency      *string           `json:"currency, omitempty"`
+	Amount        *Money            `json:"amount, omitempty"`
+	Currency      *Currency         `json:"currency, omitempty"`
 	Source        *string           `json:"source, omitempty"`
 	SourceDetails *string           `json:"source_details, omitempty"`
 	Reason        *string           `json:"reason, omitempty"`
 	Status        *string           `json:"status, omitempty"`
 	Failures      interface{}       `json:"failures, omitempty"`
 	TransferCode  *string           `json:"transfer_code, omitempty"`
+	Reference     *string           `json:"reference,omitempty"`
 	Id            *int              `json:"id, omitempty"`
 	CreatedAt     *time.Time        `json:"created_at, omitempty"`
 	UpdatedAt     *time.Time        `json:"updated_at, omitempty"`
 }
 
 type TransferRequest struct {
-	Recipient    *string `json:"recipient, omitempty"`
-	Amount       *int    `json:"amount, omitempty"`
-	Currency     *string `json:"currency, omitempty"`
-	Source       *string `json:"source, omitempty"`
-	Reason       *string `json:"reason, omitempty"`
-	TransferCode *string `json:"transfer_code, omitempty"`
+	Recipient    *string   `json:"recipient, omitempty"`
+	Amount       *Money    `json:"amount, omitempty"`
+	Currency     *Currency `json:"currency, omitempty"`
+	Source       *string   `json:"source, omitempty"`
+	Reason       *string   `json:"reason, omitempty"`
+	TransferCode *string   `json:"transfer_code, omitempty"`
+	Reference    *string   `json:"reference,omitempty"`
+}
+
+// TransferOptions filters the transfers returned by List.
+type TransferOptions struct {
+	ListOptions
+
+	// Recipient filters transfers by recipient id.
+	Recipient int `url:"recipient,omitempty"`
+
+	// Status filters transfers by status, such as "pending", "success",
+	// "failed", "reversed" or "otp".
+	Status string `url:"status,omitempty"`
+
+	// From and To limit transfers to those created in the range.
+	From time.Time `url:"from,omitempty"`
+	To   time.Time `url:"to,omitempty"`
 }
 
 type FinalizeTransferRequest struct {
@@ -41,7 +60,7 @@ type FinalizeTransferRequest struct {
 }
 
 type BulkTransferRequest struct {
-	Currency  *string           `json:"currency, omitempty"`
+	Currency  *Currency         `json:"currency, omitempty"`
 	Source    *string           `json:"source, omitempty"`
 	Transfers []TransferRequest `json:"transfers, omitempty"`
 }
@@ -52,25 +71,26 @@ type BulkTransferRequest struct {
 // https://developers.paystack.co/reference#initiate-transfer
 func (s *TransferService) Initiate(ctx context.Context, t *TransferRequest) (*Transfer, *Response, error) {
 	u := fmt.Sprintf("transfer")
+	if t != nil {
+		s.client.setReference(&t.Reference)
+	}
 	req, err := s.client.NewRequest("POST", u, t)
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Transfer])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	c := new(Transfer)
-	mapDecoder(r.Data, c)
-	return c, resp, nil
+	return &r.Data, resp, nil
 }
 
 // List returns all created transfers
 //
 // Paystack API reference:
 // https://developers.paystack.co/reference#list-transfers
-func (s *TransferService) List(ctx context.Context, opt *ListOptions) ([]Transfer, *Response, error) {
+func (s *TransferService) List(ctx context.Context, opt *TransferOptions) ([]Transfer, *Response, error) {
 	u := fmt.Sprintf("transfer")
 	//Response is erroneous if opt.Page or opt.PerPage = 0
 	u, err := addOptions(u, opt)
@@ -82,19 +102,25 @@ func (s *TransferService) List(ctx context.Context, opt *ListOptions) ([]Transfe
 		return nil, nil, err
 	}
 
-	lr := new(StandardListResponse)
+	lr := new(Envelope[[]Transfer])
 	resp, err := s.client.Do(ctx, req, lr)
-
 	if err != nil {
 		return nil, resp, err
 	}
-	var ta []Transfer
-	c := new(Transfer)
-	for _, x := range lr.Data {
-		mapDecoder(x, c)
-		ta = append(ta, *c)
+	return lr.Data, resp, nil
+}
+
+// ListAll iterates over all transfers, calling List for each page
+func (s *TransferService) ListAll(ctx context.Context, opt *TransferOptions, iopt *IterOptions) iter.Seq2[Transfer, error] {
+	var o TransferOptions
+	if opt != nil {
+		o = *opt
 	}
-	return ta, resp, nil
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]Transfer, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
 }
 
 // Fetch fetches a transfer
@@ -107,14 +133,47 @@ func (s *TransferService) Fetch(ctx context.Context, id string) (*Transfer, *Res
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Transfer])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	c := new(Transfer)
-	mapDecoder(r.Data, c)
-	return c, resp, nil
+	return &r.Data, resp, nil
+}
+
+// InitiateOnce initiates a transfer like Initiate, but when the outcome of
+// an attempt is unknown it verifies the transfer by reference before
+// initiating it again, so that the recipient is paid at most once. A
+// reference is generated for t if it has none.
+//
+// If the outcome cannot be established, the error matches
+// ErrOutcomeUnknown and calling InitiateOnce again with t is safe.
+func (s *TransferService) InitiateOnce(ctx context.Context, t *TransferRequest) (*Transfer, *Response, error) {
+	if t == nil {
+		return nil, nil, errNilRequest
+	}
+	s.client.ensureReference(&t.Reference)
+	return submitOnce(ctx, s.client, *t.Reference,
+		func(ctx context.Context) (*Transfer, *Response, error) { return s.Initiate(ctx, t) },
+		s.Verify)
+}
+
+// Verify returns the transfer with the given reference
+//
+// Paystack API reference:
+// https://developers.paystack.co/reference#verify-transfer
+func (s *TransferService) Verify(ctx context.Context, reference string) (*Transfer, *Response, error) {
+	u := fmt.Sprintf("transfer/verify/" + reference)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Envelope[Transfer])
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r.Data, resp, nil
 }
 
 // Finalize
@@ -136,25 +195,24 @@ func (s *TransferService) Finalize(ctx context.Context, sa *FinalizeTransferRequ
 	return resp, nil
 }
 
-//InitiateBulkTransfer creates a new transfer
+// InitiateBulkTransfer queues up to 100 transfers at once, which requires
+// OTPs to be disabled. The queued transfers are returned in the order of the
+// request. See InitiateBulk for any number of transfers.
 //
 // Paystack API reference:
 // https://developers.paystack.co/reference#initiate-bulk-transfer
-func (s *TransferService) InitiateBulkTransfer(ctx context.Context, t *BulkTransferRequest) (*Message, *Response, error) {
-	u := fmt.Sprintf("transfer")
-	u, err := addOptions(u, t)
+func (s *TransferService) InitiateBulkTransfer(ctx context.Context, t *BulkTransferRequest) ([]Transfer, *Response, error) {
+	u := fmt.Sprintf("transfer/bulk")
 	req, err := s.client.NewRequest("POST", u, t)
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[[]Transfer])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(Message)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return r.Data, resp, nil
 }
 
 // ResendOTP
@@ -167,14 +225,12 @@ func (s *TransferService) ResendOTP(ctx context.Context, sa *TransferRequest) (*
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Message])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(Message)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
 
 // DisableOTP
@@ -187,14 +243,12 @@ func (s *TransferService) DisableOTP(ctx context.Context) (*Message, *Response,
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Message])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(Message)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
 
 // DisableOTPFinalize
@@ -207,14 +261,12 @@ func (s *TransferService) DisableOTPFinalize(ctx context.Context) (*Message, *Re
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Message])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(Message)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
 
 // EnableOTP
@@ -227,12 +279,10 @@ func (s *TransferService) EnableOTP(ctx context.Context) (*Message, *Response, e
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[Message])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	m := new(Message)
-	mapDecoder(r.Data, m)
-	return m, resp, nil
+	return &r.Data, resp, nil
 }
@@ -3,6 +3,7 @@ package paystack
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 )
 
@@ -10,19 +11,19 @@ import (
 type TransferRecipientService service
 
 type TransferRecipientRequest struct {
-	Type          *string  `json:"type, omitempty"`
-	Currency      *string  `json:"currency, omitempty"`
-	Name          *string  `json:"name, omitempty"`
-	Description   *string  `json:"description"`
-	Metadata      Metadata `json:"metadata, omitempty"`
-	AccountNumber *string  `json:"account_number, omitempty"`
-	BankCode      *string  `json:"bank_code, omitempty"`
+	Type          *string   `json:"type, omitempty"`
+	Currency      *Currency `json:"currency, omitempty"`
+	Name          *string   `json:"name, omitempty"`
+	Description   *string   `json:"description"`
+	Metadata      Metadata  `json:"metadata, omitempty"`
+	AccountNumber *string   `json:"account_number, omitempty"`
+	BankCode      *string   `json:"bank_code, omitempty"`
 }
 
 type TransferRecipient struct {
 	Domain        *string                  `json:"domain, omitempty"`
 	Type          *string                  `json:"type, omitempty"`
-	Currency      *string                  `json:"currency, omitempty"`
+	Currency      *Currency                `json:"currency, omitempty"`
 	Name          *string                  `json:"name, omitempty"`
 	Details       TransferRecipientDetails `json:"details, omitempty"`
 	Description   *string                  `json:"description"`
@@ -35,6 +36,15 @@ type TransferRecipient struct {
 	UpdatedAt     *time.Time               `json:"updated_at, omitempty"`
 }
 
+// UnmarshalJSON also accepts the recipient id or code, which some endpoints
+// return in place of the recipient object.
+func (t *TransferRecipient) UnmarshalJSON(data []byte) error {
+	type recipient TransferRecipient
+	return unmarshalRef(data, (*recipient)(t),
+		func(id int) { t.Id = &id },
+		func(code string) { t.RecipientCode = &code })
+}
+
 type TransferRecipientDetails struct {
 	AccountNumber *string `json:"account_number, omitempty"`
 	AccountName   *string `json:"account_name, omitempty"`
@@ -52,14 +62,12 @@ func (s *TransferRecipientService) Create(ctx context.Context, t *TransferRecipi
 	if err != nil {
 		return nil, nil, err
 	}
-	r := new(StandardResponse)
+	r := new(Envelope[TransferRecipient])
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
 		return nil, resp, err
 	}
-	tr := new(TransferRecipient)
-	mapDecoder(r.Data, tr)
-	return tr, resp, nil
+	return &r.Data, resp, nil
 }
 
 // List returns all created transfer recipients
@@ -78,17 +86,23 @@ func (s *TransferRecipientService) List(ctx context.Context, opt *ListOptions) (
 		return nil, nil, err
 	}
 
-	r := new(StandardListResponse)
+	r := new(Envelope[[]TransferRecipient])
 	resp, err := s.client.Do(ctx, req, r)
-
 	if err != nil {
 		return nil, resp, err
 	}
-	var ca []TransferRecipient
-	c := new(TransferRecipient)
-	for _, x := range r.Data {
-		mapDecoder(x, c)
-		ca = append(ca, *c)
+	return r.Data, resp, nil
+}
+
+// ListAll iterates over all transfer recipients, calling List for each page
+func (s *TransferRecipientService) ListAll(ctx context.Context, opt *ListOptions, iopt *IterOptions) iter.Seq2[TransferRecipient, error] {
+	var o ListOptions
+	if opt != nil {
+		o = *opt
 	}
-	return ca, resp, nil
+	return Iter(ctx, o.Page, func(ctx context.Context, page int) ([]TransferRecipient, *Response, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, &o)
+	}, iopt)
 }
@@ -0,0 +1,66 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTransferService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transfer", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"page":      "2",
+			"perPage":   "10",
+			"recipient": "28",
+			"status":    "failed",
+			"from":      "2024-01-01T00:00:00Z",
+			"to":        "2024-02-01T00:00:00Z",
+		})
+		fmt.Fprint(w, `{
+		  "status": true,
+		  "message": "Transfers retrieved",
+		  "data": [{"reference": "payout-1", "status": "failed", "transfer_code": "TRF_2x5j67tnnw1t98k", "recipient": 28}],
+		  "meta": {"total": 11, "skipped": 10, "perPage": 10, "page": 2, "pageCount": 2}
+		}`)
+	})
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	transfers, _, err := client.Transfer.List(context.Background(), &TransferOptions{
+		ListOptions: ListOptions{Page: 2, PerPage: 10},
+		Recipient:   28,
+		Status:      "failed",
+		From:        from,
+		To:          to,
+	})
+	if err != nil {
+		t.Fatalf("Transfer.List returned error: %v", err)
+	}
+	if len(transfers) != 1 || transfers[0].GetReference() != "payout-1" || transfers[0].Recipient.GetId() != 28 {
+		t.Errorf("Transfer.List returned %+v", transfers)
+	}
+}
+
+func TestTransferService_Verify(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transfer/verify/payout-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"status": true, "message": "Transfer retrieved", "data": {"reference": "payout-1", "status": "success", "amount": 50000, "currency": "NGN"}}`)
+	})
+
+	tr, _, err := client.Transfer.Verify(context.Background(), "payout-1")
+	if err != nil {
+		t.Fatalf("Transfer.Verify returned error: %v", err)
+	}
+	if tr.GetStatus() != "success" || tr.GetAmount() != (Money{Amount: 50000, Currency: NGN}) {
+		t.Errorf("Transfer.Verify returned %+v", tr)
+	}
+}
@@ -0,0 +1,169 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxBodySize limits the size of the events read by Handler.
+const maxBodySize = 1 << 20
+
+// defaultReplayWindow is how long events are remembered by the default
+// replay cache. Paystack retries failed deliveries for up to 72 hours.
+const defaultReplayWindow = 72 * time.Hour
+
+// HandlerFunc handles a verified event. Returning an error makes Handler
+// reply with a 500 status code so that Paystack delivers the event again.
+type HandlerFunc func(ctx context.Context, e *Event) error
+
+// ReplayCache remembers the events that were already handled.
+type ReplayCache interface {
+	// Add records key and reports whether it was not already present.
+	Add(key string) bool
+
+	// Remove forgets key, so that an event whose handling failed is
+	// accepted when Paystack delivers it again.
+	Remove(key string)
+}
+
+// Handler is an http.Handler that verifies the events sent by Paystack and
+// dispatches them to the callbacks registered for their type.
+//
+// Unsigned events and events with an invalid signature are rejected with a
+// 401 status code. Events that were already handled are acknowledged
+// without calling the callbacks again. Events with no registered callback
+// are acknowledged and ignored.
+type Handler struct {
+	secret string
+
+	// Replay remembers handled events. Defaults to an in-memory cache
+	// keeping events for 72 hours; set it to nil to disable replay checks.
+	Replay ReplayCache
+
+	mu       sync.RWMutex
+	handlers map[string]HandlerFunc
+	fallback HandlerFunc
+}
+
+// NewHandler returns a Handler verifying events with the given secret key,
+// usually the Secret of the paystack.Client.
+func NewHandler(secret string) *Handler {
+	return &Handler{
+		secret:   secret,
+		Replay:   NewMemoryReplayCache(defaultReplayWindow),
+		handlers: make(map[string]HandlerFunc),
+	}
+}
+
+// On registers fn as the callback for events of the given type, such as
+// EventChargeSuccess. It replaces any callback previously registered for it.
+func (h *Handler) On(eventType string, fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlers[eventType] = fn
+}
+
+// OnUnhandled registers fn as the callback for events of a type that has no
+// callback registered with On.
+func (h *Handler) OnUnhandled(fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fallback = fn
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "could not read body", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	signature := r.Header.Get(SignatureHeader)
+	if err := VerifySignature(h.secret, body, signature); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	e, err := parseEvent(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.mu.RLock()
+	fn, ok := h.handlers[e.Type]
+	if !ok {
+		fn = h.fallback
+	}
+	h.mu.RUnlock()
+	if fn == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// The signature identifies the body, which is the same for every
+	// delivery of an event.
+	key := strings.ToLower(signature)
+	if h.Replay != nil && !h.Replay.Add(key) {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if err := fn(r.Context(), e); err != nil {
+		if h.Replay != nil {
+			h.Replay.Remove(key)
+		}
+		http.Error(w, "event handling failed", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// MemoryReplayCache is a ReplayCache that keeps keys in memory for a fixed
+// window of time.
+type MemoryReplayCache struct {
+	window time.Duration
+
+	mu        sync.Mutex
+	seen      map[string]time.Time // key to expiry time
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryReplayCache returns a MemoryReplayCache remembering keys for
+// window.
+func NewMemoryReplayCache(window time.Duration) *MemoryReplayCache {
+	return &MemoryReplayCache{window: window, seen: make(map[string]time.Time), now: time.Now}
+}
+
+func (c *MemoryReplayCache) Add(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if now.Sub(c.lastSweep) > time.Minute {
+		for k, exp := range c.seen {
+			if !now.Before(exp) {
+				delete(c.seen, k)
+			}
+		}
+		c.lastSweep = now
+	}
+	if exp, ok := c.seen[key]; ok && now.Before(exp) {
+		return false
+	}
+	c.seen[key] = now.Add(c.window)
+	return true
+}
+
+func (c *MemoryReplayCache) Remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.seen, key)
+}
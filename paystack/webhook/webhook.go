@@ -0,0 +1,151 @@
+// Copyright 2017 The go-paystack AUTHORS. All rights reserved.
+
+// Package webhook receives and verifies the events Paystack sends to an
+// integration's webhook URL.
+//
+// Paystack signs every event with an HMAC-SHA512 of the request body keyed
+// with the integration's secret key, sent in the x-paystack-signature header.
+//
+//	h := webhook.NewHandler(client.Secret)
+//	h.On(webhook.EventChargeSuccess, func(ctx context.Context, e *webhook.Event) error {
+//		return fulfil(ctx, e.Transaction.GetReference())
+//	})
+//	http.Handle("/paystack/webhook", h)
+//
+// Paystack API reference:
+// https://developers.paystack.co/docs/events
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kehindesalaam/go-paystack/paystack"
+)
+
+// SignatureHeader is the request header holding the event signature.
+const SignatureHeader = "X-Paystack-Signature"
+
+// Event types sent by Paystack.
+const (
+	EventChargeSuccess            = "charge.success"
+	EventTransferSuccess          = "transfer.success"
+	EventTransferFailed           = "transfer.failed"
+	EventTransferReversed         = "transfer.reversed"
+	EventSubscriptionCreate       = "subscription.create"
+	EventSubscriptionDisable      = "subscription.disable"
+	EventSubscriptionNotRenew     = "subscription.not_renew"
+	EventSubscriptionExpiringCard = "subscription.expiring_cards"
+
+	EventDedicatedAccountAssignSuccess = "dedicatedaccount.assign.success"
+)
+
+var (
+	// ErrNoSignature is returned when the event carries no signature.
+	ErrNoSignature = errors.New("webhook: missing " + SignatureHeader + " header")
+
+	// ErrInvalidSignature is returned when the signature does not match
+	// the body of the event.
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+)
+
+// Event is an event sent by Paystack. Data holds the raw payload, and for
+// known event types the matching typed field is populated as well.
+type Event struct {
+	Type string          `json:"event"`
+	Data json.RawMessage `json:"data"`
+
+	// Set for charge.success events.
+	Transaction *paystack.Transaction `json:"-"`
+
+	// Set for transfer.success, transfer.failed and transfer.reversed events.
+	Transfer *paystack.Transfer `json:"-"`
+
+	// Set for subscription.create, subscription.disable and
+	// subscription.not_renew events.
+	Subscription *paystack.Subscription `json:"-"`
+
+	// Set for dedicatedaccount.assign.success events.
+	DedicatedAccount *DedicatedAccountAssigned `json:"-"`
+}
+
+// DedicatedAccountAssigned is the payload of a dedicatedaccount.assign.success
+// event, sent once a dedicated account has been assigned to a customer.
+type DedicatedAccountAssigned struct {
+	Customer         paystack.Customer         `json:"customer"`
+	DedicatedAccount paystack.DedicatedAccount `json:"dedicated_account"`
+	Identification   struct {
+		Status string `json:"status"`
+	} `json:"identification"`
+}
+
+// Sign returns the signature of body for the given secret key, as sent by
+// Paystack in the x-paystack-signature header.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha512.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks signature against the HMAC-SHA512 of body keyed
+// with secret.
+func VerifySignature(secret string, body []byte, signature string) error {
+	if signature == "" {
+		return ErrNoSignature
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	mac := hmac.New(sha512.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// ParseEvent verifies the signature of body and decodes it into an Event.
+func ParseEvent(secret string, body []byte, signature string) (*Event, error) {
+	if err := VerifySignature(secret, body, signature); err != nil {
+		return nil, err
+	}
+	return parseEvent(body)
+}
+
+// parseEvent decodes body into an Event without verifying it.
+func parseEvent(body []byte) (*Event, error) {
+	e := new(Event)
+	if err := json.Unmarshal(body, e); err != nil {
+		return nil, fmt.Errorf("webhook: decoding event: %v", err)
+	}
+	if e.Type == "" {
+		return nil, errors.New("webhook: event has no type")
+	}
+
+	var v interface{}
+	switch e.Type {
+	case EventChargeSuccess:
+		e.Transaction = new(paystack.Transaction)
+		v = e.Transaction
+	case EventTransferSuccess, EventTransferFailed, EventTransferReversed:
+		e.Transfer = new(paystack.Transfer)
+		v = e.Transfer
+	case EventSubscriptionCreate, EventSubscriptionDisable, EventSubscriptionNotRenew:
+		e.Subscription = new(paystack.Subscription)
+		v = e.Subscription
+	case EventDedicatedAccountAssignSuccess:
+		e.DedicatedAccount = new(DedicatedAccountAssigned)
+		v = e.DedicatedAccount
+	}
+	if v != nil && len(e.Data) > 0 {
+		if err := json.Unmarshal(e.Data, v); err != nil {
+			return nil, fmt.Errorf("webhook: decoding %s event data: %v", e.Type, err)
+		}
+	}
+	return e, nil
+}
@@ -0,0 +1,181 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testSecret = "sk_test_secret"
+
+const chargeSuccess = `{
+  "event": "charge.success",
+  "data": {
+    "id": 302961,
+    "domain": "live",
+    "status": "success",
+    "reference": "qTPrJoy9Bx",
+    "amount": 10000,
+    "gateway_response": "Approved by Financial Institution",
+    "channel": "card",
+    "currency": "NGN",
+    "metadata": 0,
+    "customer": {"id": 68324, "first_name": "BoJack", "email": "bojack@horseman.com", "customer_code": "CUS_qo38as2hpsgk2r0"},
+    "authorization": {"authorization_code": "AUTH_f5rnfq9p", "reusable": true},
+    "plan": {}
+  }
+}`
+
+func post(h http.Handler, body, signature string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest("POST", "/webhook", strings.NewReader(body))
+	if signature != "" {
+		r.Header.Set(SignatureHeader, signature)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestParseEvent(t *testing.T) {
+	e, err := ParseEvent(testSecret, []byte(chargeSuccess), Sign(testSecret, []byte(chargeSuccess)))
+	if err != nil {
+		t.Fatalf("ParseEvent returned error: %v", err)
+	}
+	if e.Type != EventChargeSuccess {
+		t.Errorf("Event.Type = %q, want %q", e.Type, EventChargeSuccess)
+	}
+	if got := e.Transaction.GetReference(); got != "qTPrJoy9Bx" {
+		t.Errorf("Event.Transaction.Reference = %q, want %q", got, "qTPrJoy9Bx")
+	}
+	if got := e.Transaction.Customer.GetCustomerCode(); got != "CUS_qo38as2hpsgk2r0" {
+		t.Errorf("Event.Transaction.Customer.CustomerCode = %q, want %q", got, "CUS_qo38as2hpsgk2r0")
+	}
+
+	_, err = ParseEvent(testSecret, []byte(chargeSuccess), Sign("sk_test_other", []byte(chargeSuccess)))
+	if err != ErrInvalidSignature {
+		t.Errorf("ParseEvent returned error %v, want %v", err, ErrInvalidSignature)
+	}
+	_, err = ParseEvent(testSecret, []byte(chargeSuccess), "")
+	if err != ErrNoSignature {
+		t.Errorf("ParseEvent returned error %v, want %v", err, ErrNoSignature)
+	}
+}
+
+func TestParseEvent_transfer(t *testing.T) {
+	body := []byte(`{"event": "transfer.success", "data": {"amount": 30000, "currency": "NGN", "status": "success", "transfer_code": "TRF_2x5j67tnnw1t98k", "recipient": {"recipient_code": "RCP_a8wkxiychzdzfgs", "details": {"account_number": "0000000000"}}}}`)
+	e, err := ParseEvent(testSecret, body, Sign(testSecret, body))
+	if err != nil {
+		t.Fatalf("ParseEvent returned error: %v", err)
+	}
+	if e.Transfer.GetTransferCode() != "TRF_2x5j67tnnw1t98k" || e.Transfer.Recipient.GetRecipientCode() != "RCP_a8wkxiychzdzfgs" {
+		t.Errorf("Event.Transfer = %+v", e.Transfer)
+	}
+}
+
+func TestParseEvent_dedicatedAccount(t *testing.T) {
+	body := []byte(`{"event": "dedicatedaccount.assign.success", "data": {
+	  "customer": {"id": 100110, "email": "johndoe@test.com", "customer_code": "CUS_hcekca0j0bbg2m4"},
+	  "dedicated_account": {"bank": {"name": "Wema Bank", "id": 20, "slug": "wema-bank"}, "account_name": "PAYSTACK/John Doe", "account_number": "1234567890", "assigned": true, "currency": "NGN", "active": true, "id": 987654},
+	  "identification": {"status": "success"}
+	}}`)
+	e, err := ParseEvent(testSecret, body, Sign(testSecret, body))
+	if err != nil {
+		t.Fatalf("ParseEvent returned error: %v", err)
+	}
+	a := e.DedicatedAccount
+	if a.Customer.GetCustomerCode() != "CUS_hcekca0j0bbg2m4" || a.DedicatedAccount.GetAccountNumber() != "1234567890" ||
+		a.DedicatedAccount.Bank.GetSlug() != "wema-bank" || a.Identification.Status != "success" {
+		t.Errorf("Event.DedicatedAccount = %+v", a)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	h := NewHandler(testSecret)
+	var got []string
+	h.On(EventChargeSuccess, func(ctx context.Context, e *Event) error {
+		got = append(got, e.Transaction.GetReference())
+		return nil
+	})
+
+	sig := Sign(testSecret, []byte(chargeSuccess))
+	if w := post(h, chargeSuccess, sig); w.Code != http.StatusOK {
+		t.Errorf("signed event: status %d, want %d", w.Code, http.StatusOK)
+	}
+	// A replayed event is acknowledged but not handled again.
+	if w := post(h, chargeSuccess, sig); w.Code != http.StatusOK {
+		t.Errorf("replayed event: status %d, want %d", w.Code, http.StatusOK)
+	}
+	if len(got) != 1 || got[0] != "qTPrJoy9Bx" {
+		t.Errorf("callback received %v, want [qTPrJoy9Bx]", got)
+	}
+
+	if w := post(h, chargeSuccess, ""); w.Code != http.StatusUnauthorized {
+		t.Errorf("unsigned event: status %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+	if w := post(h, chargeSuccess, Sign("sk_test_other", []byte(chargeSuccess))); w.Code != http.StatusUnauthorized {
+		t.Errorf("badly signed event: status %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+
+	other := `{"event": "paymentrequest.pending", "data": {}}`
+	if w := post(h, other, Sign(testSecret, []byte(other))); w.Code != http.StatusOK {
+		t.Errorf("unhandled event: status %d, want %d", w.Code, http.StatusOK)
+	}
+
+	r := httptest.NewRequest("GET", "/webhook", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET request: status %d, want %d", w.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestHandler_callbackErrorAllowsRedelivery(t *testing.T) {
+	h := NewHandler(testSecret)
+	calls := 0
+	h.On(EventChargeSuccess, func(ctx context.Context, e *Event) error {
+		calls++
+		if calls == 1 {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+
+	sig := Sign(testSecret, []byte(chargeSuccess))
+	if w := post(h, chargeSuccess, sig); w.Code != http.StatusInternalServerError {
+		t.Errorf("failed event: status %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+	if w := post(h, chargeSuccess, sig); w.Code != http.StatusOK {
+		t.Errorf("redelivered event: status %d, want %d", w.Code, http.StatusOK)
+	}
+	if calls != 2 {
+		t.Errorf("callback called %d times, want 2", calls)
+	}
+}
+
+func TestHandler_overHTTP(t *testing.T) {
+	h := NewHandler(testSecret)
+	done := make(chan string, 1)
+	h.On(EventChargeSuccess, func(ctx context.Context, e *Event) error {
+		done <- e.Type
+		return nil
+	})
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(chargeSuccess))
+	req.Header.Set("x-paystack-signature", Sign(testSecret, []byte(chargeSuccess)))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST returned error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got := <-done; got != EventChargeSuccess {
+		t.Errorf("callback received %q, want %q", got, EventChargeSuccess)
+	}
+}